@@ -0,0 +1,100 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Camelize converts v into a "PascalCase" string, like "camelize" of Ruby on Rails (ActiveSupport) does.
+// Slashes separate namespaces, which are joined using "::", so "active_model/errors" becomes "ActiveModel::Errors".
+// Each word in acronyms is treated like an acronym registered in ActiveSupport (see ToPascal), so "html_parser" becomes
+// "HTMLParser" when acronyms contains "HTML". Unlike ActiveSupport, "CamelCase" boundaries, dashes, dots and whitespace
+// separate words too. ToLowerCamel is the equivalent of "camelize(:lower)".
+// If v isn't a valid UTF-8 string, v is returned unmodified.
+func Camelize(v string, acronyms ...string) string {
+	if !utf8.ValidString(v) {
+		return v
+	}
+
+	namespaces := strings.Split(v, "/")
+
+	for idx, ns := range namespaces {
+		namespaces[idx] = ToPascal(ns, acronyms...)
+	}
+
+	return strings.Join(namespaces, "::")
+}
+
+// Underscore converts v into a "snake_case" string, like "underscore" of Ruby on Rails (ActiveSupport) does.
+// "::" separates namespaces, which are joined using slashes, so "ActiveModel::Errors" becomes "active_model/errors".
+// Acronyms are recognized without registering them, so "HTMLParser" becomes "html_parser". Unlike ActiveSupport, dots
+// and whitespace separate words too.
+// If v isn't a valid UTF-8 string, v is returned unmodified.
+// Each word in noSplit (if provided) is treated as a word that shouldn't be split.
+func Underscore(v string, noSplit ...string) string {
+	if !utf8.ValidString(v) {
+		return v
+	}
+
+	namespaces := strings.Split(v, "::")
+
+	for idx, ns := range namespaces {
+		namespaces[idx] = ToSnake(ns, noSplit...)
+	}
+
+	return strings.Join(namespaces, "/")
+}
+
+// Dasherize replaces each underscore in v by a dash, like "dasherize" of Ruby on Rails (ActiveSupport) does, so
+// "puni_puni" becomes "puni-puni".
+// NOTE: Like in ActiveSupport, the case of v is kept. ToKebab converts any naming convention into "kebab-case".
+func Dasherize(v string) string {
+	return strings.ReplaceAll(v, "_", "-")
+}
+
+// HumanizeAttribute converts the name of an attribute into a human readable string, like "humanize" of Ruby on Rails
+// (ActiveSupport) does, so "employee_salary" becomes "Employee salary" and "author_id" becomes "Author".
+// The words are determined and written like Humanize does, after which a final word "id" (ignoring case) is dropped,
+// unless it's the only word. Each word in acronyms is treated like an acronym registered in ActiveSupport, so
+// "ssl_error" becomes "SSL error" when acronyms contains "SSL".
+// NOTE: This function isn't named Humanize, since Humanize keeps all the words of v (including a final "id").
+// If v isn't a valid UTF-8 string, v is returned unmodified.
+func HumanizeAttribute(v string, acronyms ...string) string {
+	if !utf8.ValidString(v) {
+		return v
+	}
+
+	s := New(WithApostrophes(), WithAcronyms(acronyms...))
+	words := s.humanWords(v)
+
+	if len(words) > 1 && strings.EqualFold(words[len(words)-1], "id") {
+		words = words[:len(words)-1]
+	}
+
+	return s.cm.join(words, " ", upperFirstCase, keepCase)
+}
@@ -0,0 +1,139 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Convert a string into a "PascalCase" string, like ActiveSupport does.
+func TestCamelize(t *testing.T) {
+	for _, tc := range []struct {
+		vInput    string
+		vAcronyms []string
+		want      string
+	}{
+		{vInput: "", want: ""},
+		{vInput: "active_model", want: "ActiveModel"},
+		{vInput: "active_model/errors", want: "ActiveModel::Errors"},
+		{vInput: "html_parser", want: "HtmlParser"},
+		{vInput: "html_parser", vAcronyms: []string{"HTML"}, want: "HTMLParser"},
+		{vInput: "admin/user-settings", want: "Admin::UserSettings"},
+		{vInput: "active\xffmodel", want: "active\xffmodel"},
+	} {
+		// ACT.
+		got := camelcase.Camelize(tc.vInput, tc.vAcronyms...)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Convert a string into a \"PascalCase\" string, like ActiveSupport does.\n"+
+			"Input:    %v (%v)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vAcronyms, tc.want, got)
+	}
+}
+
+// UT: Convert a string into a "snake_case" string, like ActiveSupport does.
+func TestUnderscore(t *testing.T) {
+	for _, tc := range []struct {
+		vInput string
+		want   string
+	}{
+		{vInput: "", want: ""},
+		{vInput: "ActiveModel", want: "active_model"},
+		{vInput: "ActiveModel::Errors", want: "active_model/errors"},
+		{vInput: "HTMLParser", want: "html_parser"},
+		{vInput: "user-settings", want: "user_settings"},
+		{vInput: "Active\xffModel", want: "Active\xffModel"},
+	} {
+		// ACT.
+		got := camelcase.Underscore(tc.vInput)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Convert a string into a \"snake_case\" string, like ActiveSupport does.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Replace the underscores in a string by dashes, like ActiveSupport does.
+func TestDasherize(t *testing.T) {
+	for _, tc := range []struct {
+		vInput string
+		want   string
+	}{
+		{vInput: "", want: ""},
+		{vInput: "puni_puni", want: "puni-puni"},
+		{vInput: "Puni_Puni", want: "Puni-Puni"},
+		{vInput: "puniPuni", want: "puniPuni"},
+	} {
+		// ACT.
+		got := camelcase.Dasherize(tc.vInput)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Replace the underscores in a string by dashes, like ActiveSupport does.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Convert the name of an attribute into a human readable string, like ActiveSupport does.
+func TestHumanizeAttribute(t *testing.T) {
+	for _, tc := range []struct {
+		vInput    string
+		vAcronyms []string
+		want      string
+	}{
+		{vInput: "", want: ""},
+		{vInput: "employee_salary", want: "Employee salary"},
+		{vInput: "author_id", want: "Author"},
+		{vInput: "authorID", want: "Author"},
+		{vInput: "id", want: "Id"},
+		{vInput: "_id", want: "Id"},
+		{vInput: "underground", want: "Underground"},
+		{vInput: "ssl_error", want: "Ssl error"},
+		{vInput: "ssl_error", vAcronyms: []string{"SSL"}, want: "SSL error"},
+		{vInput: "author\xff_id", want: "author\xff_id"},
+	} {
+		// ACT.
+		got := camelcase.HumanizeAttribute(tc.vInput, tc.vAcronyms...)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Convert the name of an attribute into a human readable string, like ActiveSupport does.\n"+
+			"Input:    %v (%v)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vAcronyms, tc.want, got)
+	}
+}
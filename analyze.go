@@ -0,0 +1,111 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Report holds the vocabulary statistics of a set of identifiers.
+type Report struct {
+	Identifiers int            // The number of identifiers.
+	Words       map[string]int // The number of times each word (in lowercase) is used.
+	Acronyms    map[string]int // The number of times each acronym is used.
+	Styles      map[Style]int  // The number of identifiers written in each naming convention.
+	AvgWords    float64        // The average number of words per identifier.
+}
+
+// Analyze splits each name in names into words (on delimiters and on "CamelCase" boundaries) and returns the
+// vocabulary statistics of names.
+// An acronym is a word with at least two letters and no lowercase letters, such as "HTTP" in "parseHTTPHeader".
+// The words of a name that doesn't contain any lowercase runes (such as "MAX_SIZE") aren't treated as acronyms.
+// A name that isn't a valid UTF-8 string is treated as a single word (which isn't converted to lowercase).
+func Analyze(names []string) Report {
+	retVal := Report{
+		Identifiers: len(names),
+		Words:       make(map[string]int),
+		Acronyms:    make(map[string]int),
+		Styles:      make(map[Style]int),
+	}
+
+	total := 0
+
+	for _, name := range names {
+		retVal.Styles[Detect(name)]++
+
+		// NOTE: A name that isn't a valid UTF-8 string is counted as it is, since it can't be converted to lowercase.
+		if !utf8.ValidString(name) {
+			retVal.Words[name]++
+			total = total + 1
+
+			continue
+		}
+
+		words := splitWords(name, nil)
+		total = total + len(words)
+
+		for _, w := range words {
+			retVal.Words[strings.ToLower(w)]++
+
+			if hasLower(name) && isAcronym(w) {
+				retVal.Acronyms[w]++
+			}
+		}
+	}
+
+	if len(names) > 0 {
+		retVal.AvgWords = float64(total) / float64(len(names))
+	}
+
+	return retVal
+}
+
+// TopWords returns the n most frequently used words (in lowercase), ordered by the number of times they're used.
+// Words that are used equally often are ordered alphabetically.
+// If n is negative, or larger than the number of distinct words, all the words are returned.
+func (r Report) TopWords(n int) []string {
+	retVal := make([]string, 0, len(r.Words))
+
+	for w := range r.Words {
+		retVal = append(retVal, w)
+	}
+
+	sort.Slice(retVal, func(i, j int) bool {
+		if r.Words[retVal[i]] != r.Words[retVal[j]] {
+			return r.Words[retVal[i]] > r.Words[retVal[j]]
+		}
+
+		return retVal[i] < retVal[j]
+	})
+
+	if n >= 0 && n < len(retVal) {
+		retVal = retVal[:n]
+	}
+
+	return retVal
+}
@@ -0,0 +1,127 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Analyze the vocabulary of a set of identifiers.
+func TestAnalyze(t *testing.T) {
+	for _, tc := range []struct {
+		vInput       []string
+		wantAvgWords float64
+		wantWords    map[string]int
+		wantAcronyms map[string]int
+		wantStyles   map[camelcase.Style]int
+	}{
+		{
+			vInput:       []string{},
+			wantWords:    map[string]int{},
+			wantAcronyms: map[string]int{},
+			wantStyles:   map[camelcase.Style]int{},
+		},
+		{
+			vInput:       []string{"parseHTTPHeader", "user_id", "MAX_HTTP_SIZE", "UserID", "BadUTF8\xe2\xe2\xa1"},
+			wantAvgWords: 2.2,
+			wantWords: map[string]int{
+				"parse": 1, "http": 2, "header": 1, "user": 2, "id": 2, "max": 1, "size": 1, "BadUTF8\xe2\xe2\xa1": 1,
+			},
+			wantAcronyms: map[string]int{"HTTP": 1, "ID": 1},
+			wantStyles: map[camelcase.Style]int{
+				camelcase.Unknown: 1, camelcase.Camel: 1, camelcase.Pascal: 1,
+				camelcase.Snake: 1, camelcase.ScreamingSnake: 1,
+			},
+		},
+	} {
+		// ACT.
+		got := camelcase.Analyze(tc.vInput)
+
+		// ASSERT.
+		assert.Equal(t, got.Identifiers, len(tc.vInput), "", "\n\n"+
+			"UT Name:  Analyze the vocabulary of a set of identifiers.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected (identifiers): %v\033[0m\n"+
+			"\033[31mActual (identifiers):   %v\033[0m\n\n", tc.vInput, len(tc.vInput), got.Identifiers)
+
+		assert.Equal(t, got.AvgWords, tc.wantAvgWords, "", "\n\n"+
+			"UT Name:  Analyze the vocabulary of a set of identifiers.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected (average words): %v\033[0m\n"+
+			"\033[31mActual (average words):   %v\033[0m\n\n", tc.vInput, tc.wantAvgWords, got.AvgWords)
+
+		// NOTE: The maps are compared using their formatted representation, which has sorted keys.
+		assert.Equal(t, fmt.Sprint(got.Words), fmt.Sprint(tc.wantWords), "", "\n\n"+
+			"UT Name:  Analyze the vocabulary of a set of identifiers.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected (words): %v\033[0m\n"+
+			"\033[31mActual (words):   %v\033[0m\n\n", tc.vInput, tc.wantWords, got.Words)
+
+		assert.Equal(t, fmt.Sprint(got.Acronyms), fmt.Sprint(tc.wantAcronyms), "", "\n\n"+
+			"UT Name:  Analyze the vocabulary of a set of identifiers.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected (acronyms): %v\033[0m\n"+
+			"\033[31mActual (acronyms):   %v\033[0m\n\n", tc.vInput, tc.wantAcronyms, got.Acronyms)
+
+		assert.Equal(t, fmt.Sprint(got.Styles), fmt.Sprint(tc.wantStyles), "", "\n\n"+
+			"UT Name:  Analyze the vocabulary of a set of identifiers.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected (styles): %v\033[0m\n"+
+			"\033[31mActual (styles):   %v\033[0m\n\n", tc.vInput, tc.wantStyles, got.Styles)
+	}
+}
+
+// UT: Get the most frequently used words of a set of identifiers.
+func TestReportTopWords(t *testing.T) {
+	// ARRANGE.
+	report := camelcase.Analyze([]string{"getUserName", "setUserName", "get_user_id", "UserID"})
+
+	for _, tc := range []struct {
+		vInput int
+		want   []string
+	}{
+		{vInput: 0, want: []string{}},
+		{vInput: 2, want: []string{"user", "get"}},
+		{vInput: 4, want: []string{"user", "get", "id", "name"}},
+		{vInput: -1, want: []string{"user", "get", "id", "name", "set"}},
+		{vInput: 10, want: []string{"user", "get", "id", "name", "set"}},
+	} {
+		// ACT.
+		got := report.TopWords(tc.vInput)
+
+		// ASSERT.
+		assert.EqualS(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Get the most frequently used words of a set of identifiers.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
@@ -0,0 +1,95 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import (
+	"strconv"
+	"strings"
+)
+
+// A Builder composes an identifier from words that are added one by one, such as the parts of a name produced by a
+// code generator, so the identifier can be rendered in any naming convention with the acronyms cased correctly.
+// The zero value holds no words and is ready to use.
+type Builder struct {
+	words []string
+}
+
+// Add splits v into words (on delimiters and on "CamelCase" boundaries) and adds them to b as regular words, which are
+// converted to lowercase, so adding "Http" or "HTTP" adds the word "http".
+// It returns b, so calls can be chained.
+func (b *Builder) Add(v string) *Builder {
+	sIdx := len(b.words)
+	b.words = appendParsed(b.words, v)
+
+	for idx := sIdx; idx < len(b.words); idx++ {
+		b.words[idx] = strings.ToLower(b.words[idx])
+	}
+
+	return b
+}
+
+// AddAcronym adds v to b as an acronym, which is converted to uppercase, so adding "http" adds the word "HTTP".
+// Empty strings are ignored.
+// It returns b, so calls can be chained.
+func (b *Builder) AddAcronym(v string) *Builder {
+	if len(v) > 0 {
+		b.words = append(b.words, strings.ToUpper(v))
+	}
+
+	return b
+}
+
+// AddNumber adds the decimal representation of n to b as a separate word.
+// NOTE: n is unsigned, since a minus sign isn't allowed in an identifier.
+// It returns b, so calls can be chained.
+func (b *Builder) AddNumber(n uint) *Builder {
+	b.words = append(b.words, strconv.FormatUint(uint64(n), 10))
+
+	return b
+}
+
+// Render combines the words of b into a single string using the naming convention to.
+// Acronyms are kept in uppercase where to allows it, so the words "http", "ID" and "parser" are rendered as
+// "HttpIDParser" using Pascal and as "http_id_parser" using Snake. When the first word is an acronym, it's written in
+// lowercase using Camel, so "HTTP" and "server" are rendered as "httpServer".
+func (b *Builder) Render(to Style) string {
+	return Format(b.words, to)
+}
+
+// Words returns the words of b, so they can be modified further.
+func (b *Builder) Words() Words {
+	return Words{words: append([]string(nil), b.words...)}
+}
+
+// Len returns the number of words in b.
+func (b *Builder) Len() int {
+	return len(b.words)
+}
+
+// Reset removes all the words from b.
+func (b *Builder) Reset() {
+	b.words = b.words[:0]
+}
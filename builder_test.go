@@ -0,0 +1,138 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Compose an identifier from words and render it in a naming convention.
+func TestBuilderRender(t *testing.T) {
+	// ARRANGE.
+	var b camelcase.Builder
+
+	b.AddAcronym("http").Add("request").AddAcronym("Id").AddNumber(2)
+
+	for _, tc := range []struct {
+		vStyle camelcase.Style
+		want   string
+	}{
+		{vStyle: camelcase.Pascal, want: "HTTPRequestID2"},
+		{vStyle: camelcase.Camel, want: "httpRequestID2"},
+		{vStyle: camelcase.Snake, want: "http_request_id_2"},
+		{vStyle: camelcase.ScreamingSnake, want: "HTTP_REQUEST_ID_2"},
+		{vStyle: camelcase.Kebab, want: "http-request-id-2"},
+		{vStyle: camelcase.Title, want: "HTTP Request ID 2"},
+	} {
+		// ACT.
+		got := b.Render(tc.vStyle)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Compose an identifier from words and render it in a naming convention.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vStyle, tc.want, got)
+	}
+}
+
+// UT: Add words to a Builder.
+func TestBuilderAdd(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		build func(b *camelcase.Builder)
+		want  []string
+	}{
+		{name: "Nothing", build: func(*camelcase.Builder) {}, want: []string{}},
+		{name: "Add a word", build: func(b *camelcase.Builder) { b.Add("Http") }, want: []string{"http"}},
+		{
+			name:  "Add multiple words",
+			build: func(b *camelcase.Builder) { b.Add("userAccount_HTML") },
+			want:  []string{"user", "account", "html"},
+		},
+		{name: "Add an empty word", build: func(b *camelcase.Builder) { b.Add("") }, want: []string{}},
+		{name: "Add an acronym", build: func(b *camelcase.Builder) { b.AddAcronym("url") }, want: []string{"URL"}},
+		{name: "Add an empty acronym", build: func(b *camelcase.Builder) { b.AddAcronym("") }, want: []string{}},
+		{name: "Add a number", build: func(b *camelcase.Builder) { b.AddNumber(42) }, want: []string{"42"}},
+		{name: "Add zero", build: func(b *camelcase.Builder) { b.AddNumber(0) }, want: []string{"0"}},
+		{
+			name:  "Reset",
+			build: func(b *camelcase.Builder) { b.Add("user").Reset() },
+			want:  []string{},
+		},
+	} {
+		// ARRANGE.
+		var b camelcase.Builder
+
+		// ACT.
+		tc.build(&b)
+		got := b.Words().Slice()
+
+		// ASSERT.
+		assert.EqualS(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Add words to a Builder.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v (%v words)\033[0m\n\n", tc.name, tc.want, got, b.Len())
+	}
+}
+
+// UT: Compose an identifier that ends with a number and render it as a valid Go identifier.
+func TestBuilderAddNumberIsValid(t *testing.T) {
+	// ARRANGE.
+	var b camelcase.Builder
+
+	b.Add("retry").AddNumber(^uint(0))
+
+	for _, style := range []camelcase.Style{camelcase.Camel, camelcase.Pascal, camelcase.Snake} {
+		// ACT.
+		got := b.Render(style)
+
+		// ASSERT.
+		assert.Equal(t, camelcase.IsValidGoIdentifier(got), true, "", "\n\n"+
+			"UT Name:  Compose an identifier that ends with a number and render it as a valid Go identifier.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: A valid Go identifier\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", style, got)
+	}
+}
+
+// Benchmark: Compose an identifier from words and render it in a naming convention.
+func BenchmarkBuilder(b *testing.B) {
+	// RESET.
+	b.ResetTimer()
+
+	// EXECUTION.
+	for i := 0; i < b.N; i++ {
+		var builder camelcase.Builder
+
+		_ = builder.AddAcronym("http").Add("request").AddAcronym("id").Render(camelcase.Pascal)
+	}
+}
@@ -0,0 +1,92 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import (
+	"container/list"
+	"sync"
+)
+
+// A size-bounded cache holding the words of the most recently split strings.
+// When the cache is full, the words of the least recently used string are removed.
+// It's safe for concurrent use.
+type lruCache struct {
+	mu      sync.Mutex               // Guards the fields below.
+	size    int                      // The maximum number of strings in the cache.
+	entries map[string]*list.Element // The element (in order) of each string in the cache.
+	order   *list.List               // The entries in the cache, from the most to the least recently used one.
+}
+
+// An entry in the cache.
+type lruEntry struct {
+	key   string   // The string that was split.
+	words []string // The words of the string.
+}
+
+// Returns a cache that holds (at most) size strings.
+func newLRUCache(size int) *lruCache {
+	return &lruCache{size: size, entries: make(map[string]*list.Element, size), order: list.New()}
+}
+
+// Returns the words of v and a flag indicating if v is found in c.
+// The returned slice is shared by all the callers, so it must not be modified.
+func (c *lruCache) get(v string) ([]string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[v]
+
+	if !ok {
+		return nil, false
+	}
+
+	c.order.MoveToFront(elem)
+
+	return elem.Value.(*lruEntry).words, true
+}
+
+// Add the words of v to c, removing the least recently used string when c is full.
+// The cache takes ownership of words, so it must not be modified afterwards.
+func (c *lruCache) add(v string, words []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[v]; ok {
+		elem.Value.(*lruEntry).words = words
+		c.order.MoveToFront(elem)
+
+		return
+	}
+
+	if c.order.Len() >= c.size {
+		oldest := c.order.Back()
+
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lruEntry).key)
+	}
+
+	c.entries[v] = c.order.PushFront(&lruEntry{key: v, words: words})
+}
@@ -27,127 +27,838 @@
 package camelcase
 
 import (
+	"iter"
+	"strconv"
 	"strings"
 	"unicode"
 	"unicode/utf8"
+	"unsafe"
+)
+
+// InvalidUTF8Error is returned when a string isn't a valid UTF-8 string.
+type InvalidUTF8Error struct {
+	Offset int // The offset (in bytes) of the first invalid UTF-8 sequence.
+}
+
+// Error returns a description of e.
+func (e *InvalidUTF8Error) Error() string {
+	return "camelcase: invalid UTF-8 sequence at offset " + strconv.Itoa(e.Offset)
+}
 
-	"github.com/kdeconinck/slices"
+// The classes a rune can belong to.
+const (
+	classUpper uint8 = 1 << iota // An uppercase (or titlecase) rune.
+	classLower                   // A lowercase rune.
+	classDigit                   // A decimal digit.
 )
 
+// The classes of each ASCII character, indexed by the character.
+// Classifying ASCII characters using this table avoids the (more expensive) lookups in the "unicode" package.
+var asciiClass = func() [256]uint8 {
+	var retVal [256]uint8
+
+	for c := 'A'; c <= 'Z'; c++ {
+		retVal[c] = classUpper
+	}
+
+	for c := 'a'; c <= 'z'; c++ {
+		retVal[c] = classLower
+	}
+
+	for c := '0'; c <= '9'; c++ {
+		retVal[c] = classDigit
+	}
+
+	return retVal
+}()
+
 // Holds information about a single rune.
 type runeInfo struct {
-	r rune
+	r     rune
+	size  int   // The length (in bytes) of the UTF-8 encoding of the rune (including its combining marks).
+	class uint8 // The classes of the rune (computed once, when the rune is decoded).
+}
+
+// Returns the classes of r.
+func classify(r rune) uint8 {
+	if r < utf8.RuneSelf {
+		return asciiClass[r]
+	}
+
+	switch {
+	case unicode.IsUpper(r) || unicode.IsTitle(r):
+		return classUpper
+	case unicode.IsLower(r):
+		return classLower
+	case unicode.IsDigit(r):
+		return classDigit
+	}
+
+	return 0
+}
+
+// Decode the first rune in v, including the combining marks that follow it.
+// A word boundary is never inserted between a rune and its combining marks, so they are treated as a single unit which
+// is classified by its first rune.
+func decodeRune(v string) runeInfo {
+	// NOTE: An ASCII character that's followed by another ASCII character (or nothing) has no combining marks.
+	if v[0] < utf8.RuneSelf && (len(v) == 1 || v[1] < utf8.RuneSelf) {
+		return runeInfo{rune(v[0]), 1, asciiClass[v[0]]}
+	}
+
+	r, size := utf8.DecodeRuneInString(v)
+
+	for size < len(v) {
+		m, mSize := utf8.DecodeRuneInString(v[size:])
+
+		if !isMark(m) {
+			break
+		}
+
+		size = size + mSize
+	}
+
+	return runeInfo{r, size, classify(r)}
+}
+
+// Checks whether or not r is a combining mark.
+func isMark(r rune) bool {
+	return r >= utf8.RuneSelf && unicode.Is(unicode.M, r)
+}
+
+// Checks whether or not r is a digit.
+func isDigit(r rune) bool {
+	if r < utf8.RuneSelf {
+		return asciiClass[r]&classDigit != 0
+	}
+
+	return unicode.IsDigit(r)
+}
+
+// Checks whether or not r is a lowercase rune.
+func isLower(r rune) bool {
+	if r < utf8.RuneSelf {
+		return asciiClass[r]&classLower != 0
+	}
+
+	return unicode.IsLower(r)
 }
 
 // Checks whether or not the rune represented by rInfo is a digit.
 func (rInfo *runeInfo) isDigit() bool {
-	return unicode.IsDigit(rInfo.r)
+	return rInfo.class&classDigit != 0
+}
+
+// Checks whether or not the rune represented by rInfo is a lowercase rune.
+func (rInfo *runeInfo) isLowercase() bool {
+	return rInfo.class&classLower != 0
 }
 
-// Checks whether or not the rune represented by rInfo is an uppercase rune.
+// Checks whether or not the rune represented by rInfo is an uppercase (or titlecase) rune.
+// Titlecase runes (such as "ǅ") start a word, just like uppercase runes do.
 func (rInfo *runeInfo) isUppercase() bool {
-	return unicode.IsUpper(rInfo.r)
+	return rInfo.class&classUpper != 0
 }
 
 // A reader designed for reading "CamelCase" strings.
+// The input is read in a single forward pass: each rune is decoded (and classified) once, and the words that shouldn't
+// be split are matched incrementally, while the word is read.
 type rdr struct {
 	input       string   // The data this reader operates on.
 	pos         int      // The position of this reader.
 	hasNextRune bool     // A flag indicating if there's a next rune.
 	rdRune      runeInfo // Information about the last rune that was read.
-	nxtRune     runeInfo // Information about the next rune that's about to be read.
+	nxtRune     runeInfo // Information about the next rune that's about to be read (decoded when size > 0).
+	noSplit     *trie    // The words that shouldn't be split.
+	wholeWords  *trie    // The words that shouldn't be split, but only when they are found as a whole word.
+	node        *trie    // The node in noSplit representing the part of the word that's read so far (if any).
+
+	scriptBoundaries bool                // A flag indicating if a word boundary is inserted when the script changes.
+	numberLiterals   bool                // A flag indicating if hexadecimal, binary and octal literals are recognized.
+	versionTokens    bool                // A flag indicating if version tokens (such as "V1Beta1") are recognized.
+	unitSuffixes     *trie               // The unit (and currency) suffixes that are kept with their number.
+	apostrophes      bool                // A flag indicating if apostrophes between uppercase runes are part of a word.
+	hyphens          bool                // A flag indicating if hyphens between letters are part of a word.
+	classifier       Classifier          // The classifier used to classify the runes (nil for the default one).
+	script           *unicode.RangeTable // The script of the last rune that was read (cached).
 }
 
 // Read the next rune from r.
 func (r *rdr) readRune() {
-	r.rdRune = runeInfo{rune(r.input[r.pos])}
-	r.pos = r.pos + 1
+	if r.nxtRune.size == 0 {
+		r.nxtRune = r.decodeRune(r.input[r.pos:])
+	}
+
+	r.rdRune = r.nxtRune
+	r.pos = r.pos + r.rdRune.size
 	r.hasNextRune = r.pos < len(r.input)
+	r.nxtRune = runeInfo{}
+
+	if r.node != nil {
+		r.node = r.node.walk(r.input[r.pos-r.rdRune.size : r.pos])
+	}
 
 	if r.hasNextRune {
-		r.nxtRune = runeInfo{rune(r.input[r.pos])}
+		r.nxtRune = r.decodeRune(r.input[r.pos:])
 	}
 }
 
-// Undo the last rune from r.
+// Decode the first rune in v (see decodeRune), and classify it using the classifier of r (if any).
+func (r *rdr) decodeRune(v string) runeInfo {
+	rInfo := decodeRune(v)
+
+	if r.classifier != nil {
+		rInfo.class = classifyWith(r.classifier, rInfo.r)
+	}
+
+	return rInfo
+}
+
+// Move r back to the start of the last rune that was read, so the word that's read ends before that rune.
+// The rune isn't decoded again, since it becomes the next rune that's about to be read.
 func (r *rdr) unreadRune() {
-	r.pos = r.pos - 1
+	r.pos = r.pos - r.rdRune.size
 	r.nxtRune = r.rdRune
-	r.rdRune = runeInfo{rune(r.input[r.pos])}
 	r.hasNextRune = true // NOTE: An undo operation means that there will be always a next rune.
 }
 
 // Verify if the word that's currently read by r is a word that should NOT be split.
-// If noSplit contains a word that starts with the word that's currently read by r, this function returns true, false
-// otherwise.
-func (r *rdr) isNoSplitWord(sIdx int, noSplit []string) bool {
-	return slices.ContainsFn(noSplit, r.input[sIdx:r.pos+1], func(got, want string) bool {
-		return strings.HasPrefix(got, want)
+// If r.noSplit contains a word that starts with the word that's currently read by r (followed by the next rune), this
+// function returns true, false otherwise.
+func (r *rdr) isNoSplitWord() bool {
+	return r.node != nil && r.node.walk(r.input[r.pos:r.pos+r.nxtRune.size]) != nil
+}
+
+// Returns the length (in bytes) of the longest word in r.wholeWords that's found at the position of r and that's
+// followed by a word boundary, or 0 if there's no such word.
+func (r *rdr) wholeWordLen() int {
+	if r.wholeWords == nil {
+		return 0
+	}
+
+	rest := r.input[r.pos:]
+
+	return r.wholeWords.longestPrefix(rest, func(n int) bool {
+		return isWordEnd(rest, n)
 	})
 }
 
+// Checks whether or not the word v[:n] ends at a word boundary.
+// A word ends at a word boundary when it isn't followed by a lowercase rune or a combining mark, or by a digit when it
+// ends with a digit.
+func isWordEnd(v string, n int) bool {
+	if n == len(v) {
+		return true
+	}
+
+	last, _ := utf8.DecodeLastRuneInString(v[:n])
+	next, _ := utf8.DecodeRuneInString(v[n:])
+
+	return !isLower(next) && !isMark(next) && !(isDigit(last) && isDigit(next))
+}
+
 // Read the next part from r.
-// Each word in noSplit (if provided) is treated as a word that shouldn't be split.
-func (r *rdr) readNextPart(noSplit []string) string {
+func (r *rdr) readNextPart() string {
 	sIdx := r.pos
+	r.node = r.noSplit
+
+	if n := r.wholeWordLen(); n > 0 {
+		for r.pos < sIdx+n {
+			r.readRune()
+		}
+
+		return r.input[sIdx:r.pos]
+	}
+
+	if n := r.versionLen(); n > 0 {
+		for r.pos < sIdx+n {
+			r.readRune()
+		}
+
+		return r.input[sIdx:r.pos]
+	}
 
 	r.readRune()
 
 	if r.rdRune.isDigit() {
-		return r.readNumber(sIdx, noSplit)
+		return r.readNumber(sIdx)
 	}
 
-	return r.readWord(sIdx, noSplit)
+	r.readWord(sIdx)
+
+	// NOTE: A hyphen between 2 letters joins the word that's read so far with the next word (such as "X-Ray").
+	for r.isIntraWordHyphen() {
+		r.readRune()
+		r.readRune()
+		r.readWord(sIdx)
+	}
+
+	return r.input[sIdx:r.pos]
 }
 
 // Read and return a number from r.
-func (r *rdr) readNumber(sIdx int, noSplit []string) string {
+// An ordinal suffix (such as "nd" in "2ndGeneration") is kept with its number.
+func (r *rdr) readNumber(sIdx int) string {
+	if n := r.numberLiteralLen(sIdx); n > 0 {
+		for r.pos < sIdx+n {
+			r.readRune()
+		}
+
+		return r.input[sIdx:r.pos]
+	}
+
 	if r.hasNextRune && r.nxtRune.isDigit() {
-		for r.hasNextRune && (r.nxtRune.isDigit() || r.isNoSplitWord(sIdx, noSplit)) {
+		for r.hasNextRune && (r.nxtRune.isDigit() || r.isNoSplitWord()) {
 			r.readRune()
 		}
+	}
+
+	if isOrdinalSuffix(r.input[sIdx:r.pos], r.input[r.pos:]) {
+		r.readRune()
+		r.readRune()
 
 		return r.input[sIdx:r.pos]
 	}
 
+	if n := r.unitSuffixLen(); n > 0 {
+		for eIdx := r.pos + n; r.pos < eIdx; {
+			r.readRune()
+		}
+	}
+
 	return r.input[sIdx:r.pos]
 }
 
+// Returns the length (in bytes) of the longest unit suffix in r.unitSuffixes that's found at the position of r and
+// that's followed by a word boundary, or 0 if there's no such suffix.
+func (r *rdr) unitSuffixLen() int {
+	if r.unitSuffixes == nil {
+		return 0
+	}
+
+	rest := r.input[r.pos:]
+
+	return r.unitSuffixes.longestPrefix(rest, func(n int) bool {
+		return isWordEnd(rest, n)
+	})
+}
+
+// Returns the length (in bytes) of the hexadecimal ("0xFF"), binary ("0b1010") or octal ("0o755") literal that starts
+// at sIdx, after its leading "0" was read, or 0 if there's no such literal (or when r doesn't recognize literals).
+// The digits of a literal are read greedily, so "0xFFVariant" holds the literal "0xFF", but "0xFFAdd" holds "0xFFAdd".
+func (r *rdr) numberLiteralLen(sIdx int) int {
+	rest := r.input[r.pos:]
+
+	if !r.numberLiterals || r.input[sIdx:r.pos] != "0" || len(rest) < 2 {
+		return 0
+	}
+
+	var isLiteralDigit func(c byte) bool
+
+	switch rest[0] {
+	case 'x', 'X':
+		isLiteralDigit = func(c byte) bool {
+			return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+		}
+	case 'b', 'B':
+		isLiteralDigit = func(c byte) bool { return c == '0' || c == '1' }
+	case 'o', 'O':
+		isLiteralDigit = func(c byte) bool { return c >= '0' && c <= '7' }
+	default:
+		return 0
+	}
+
+	n := 1
+
+	for n < len(rest) && isLiteralDigit(rest[n]) {
+		n = n + 1
+	}
+
+	if n == 1 {
+		return 0
+	}
+
+	return 1 + n
+}
+
+// Returns the length (in bytes) of the version token (such as "V2", "V1Beta1" or "v2alpha3") that's found at the
+// position of r and that's followed by a word boundary, or 0 if there's no such token (or when r doesn't recognize
+// version tokens).
+func (r *rdr) versionLen() int {
+	rest := r.input[r.pos:]
+
+	if !r.versionTokens || len(rest) < 2 || (rest[0] != 'V' && rest[0] != 'v') {
+		return 0
+	}
+
+	n := 1 + asciiDigitsLen(rest[1:])
+
+	if n == 1 {
+		return 0
+	}
+
+	for _, stage := range []string{"Alpha", "Beta", "alpha", "beta"} {
+		if strings.HasPrefix(rest[n:], stage) {
+			if m := asciiDigitsLen(rest[n+len(stage):]); m > 0 {
+				n = n + len(stage) + m
+			}
+
+			break
+		}
+	}
+
+	if !isWordEnd(rest, n) {
+		return 0
+	}
+
+	return n
+}
+
+// Returns the length (in bytes) of the sequence of ASCII digits at the start of v.
+func asciiDigitsLen(v string) int {
+	n := 0
+
+	for n < len(v) && v[n] >= '0' && v[n] <= '9' {
+		n = n + 1
+	}
+
+	return n
+}
+
+// Checks whether or not rest starts with the ordinal suffix of the number v ("st", "nd", "rd" or "th", depending on
+// the last digits of v), followed by a word boundary.
+// Only numbers consisting of ASCII digits have an ordinal suffix.
+func isOrdinalSuffix(v, rest string) bool {
+	if len(rest) < 2 || v[len(v)-1] < '0' || v[len(v)-1] > '9' {
+		return false
+	}
+
+	suffix := "th"
+
+	if len(v) < 2 || v[len(v)-2] != '1' {
+		switch v[len(v)-1] {
+		case '1':
+			suffix = "st"
+		case '2':
+			suffix = "nd"
+		case '3':
+			suffix = "rd"
+		}
+	}
+
+	return rest[:2] == suffix && isWordEnd(rest, 2)
+}
+
 // Read and return a word from r.
-func (r *rdr) readWord(sIdx int, noSplit []string) string {
-	if r.hasNextRune && r.nxtRune.isUppercase() {
-		for r.hasNextRune && (r.nxtRune.isUppercase() || r.isNoSplitWord(sIdx, noSplit)) {
+func (r *rdr) readWord(sIdx int) string {
+	if r.hasNextRune && r.nxtRune.isUppercase() && !r.isScriptChange() {
+		for r.hasNextRune && (r.isNoSplitWord() || (r.nxtRune.isUppercase() && !r.isScriptChange()) ||
+			r.isIntraWordApostrophe()) {
 			r.readRune()
 		}
 
-		if r.hasNextRune && (!r.nxtRune.isUppercase() && !r.nxtRune.isDigit()) {
+		// NOTE: The last uppercase rune only starts a new word when it's followed by a lowercase rune (or a mark), so
+		//       "HTMLParser" is split into "HTML" and "Parser", but "MY_VALUE" isn't split into "M" and "Y_VALUE".
+		if r.hasNextRune && (r.nxtRune.isLowercase() || isMark(r.nxtRune.r)) && !r.isScriptChange() {
 			r.unreadRune()
 		}
 
 		return r.input[sIdx:r.pos]
 	}
 
-	for r.hasNextRune && (r.isNoSplitWord(sIdx, noSplit) || (!r.nxtRune.isUppercase() && !r.nxtRune.isDigit())) {
+	for r.hasNextRune && (r.isNoSplitWord() || (!r.nxtRune.isUppercase() && !r.nxtRune.isDigit() &&
+		!r.isScriptChange() && !r.isIntraWordHyphen())) {
 		r.readRune()
 	}
 
 	return r.input[sIdx:r.pos]
 }
 
+// Checks whether or not the next rune that's about to be read is an apostrophe that's followed by an uppercase rune, so
+// it's part of an uppercase word (such as "USER'S").
+// If r doesn't treat apostrophes as part of a word, this function always returns false.
+func (r *rdr) isIntraWordApostrophe() bool {
+	if !r.apostrophes || !isApostrophe(r.nxtRune.r) {
+		return false
+	}
+
+	next, _ := utf8.DecodeRuneInString(r.input[r.pos+r.nxtRune.size:])
+
+	return unicode.IsUpper(next)
+}
+
+// Checks whether or not the next rune that's about to be read is a hyphen between the last rune that was read and
+// another letter, so it's part of a word (such as "X-Ray").
+// If r doesn't treat hyphens as part of a word, this function always returns false.
+func (r *rdr) isIntraWordHyphen() bool {
+	if !r.hyphens || !r.hasNextRune || r.nxtRune.r != '-' || !unicode.IsLetter(r.rdRune.r) {
+		return false
+	}
+
+	next, _ := utf8.DecodeRuneInString(r.input[r.pos+r.nxtRune.size:])
+
+	return unicode.IsLetter(next)
+}
+
+// Checks whether or not r is an apostrophe (a typewriter or a typographic one).
+func isApostrophe(r rune) bool {
+	return r == '\'' || r == '’'
+}
+
+// Checks whether or not the script (such as Latin, Cyrillic or Han) changes between the last rune that was read and
+// the next rune that's about to be read.
+// If r doesn't insert a word boundary when the script changes, this function always returns false.
+// Runes that don't belong to a specific script (such as digits and punctuation) never change the script.
+func (r *rdr) isScriptChange() bool {
+	if !r.scriptBoundaries {
+		return false
+	}
+
+	if r.script == nil || !unicode.Is(r.script, r.rdRune.r) {
+		r.script = scriptOf(r.rdRune.r)
+	}
+
+	return r.script != nil && unicode.IsLetter(r.nxtRune.r) && !unicode.Is(r.script, r.nxtRune.r)
+}
+
+// Returns the script that r belongs to, or nil if r doesn't belong to a specific script.
+func scriptOf(r rune) *unicode.RangeTable {
+	if r < utf8.RuneSelf {
+		if unicode.IsLetter(r) {
+			return unicode.Latin
+		}
+
+		return nil
+	}
+
+	for name, table := range unicode.Scripts {
+		if unicode.Is(table, r) {
+			if name == "Common" || name == "Inherited" {
+				return nil
+			}
+
+			return table
+		}
+	}
+
+	return nil
+}
+
 // Split reads v treating it as a "CamelCase" and returns the different words.
+// Each sequence of decimal digits (in any script, such as "42", "٤٢" or "४२") is returned as a separate word, together
+// with its ordinal suffix (if any), so "2ndGeneration" is split into "2nd" and "Generation".
+// Split doesn't drop any runes (see Reassemble), so a delimiter is kept with the word that follows it, and
+// "MY_CONSTANT_VALUE" is split into "MY", "_CONSTANT" and "_VALUE". Use Parse to split v on delimiters as well.
+// If v isn't a valid UTF-8 string, or when v is an empty string, a slice with one element (v) is returned.
+// Each word in noSplit (if provided) is treated as a word that shouldn't be split.
+// Split accepts any string type (e.g. "type FieldName string") and returns the words using that same type.
+func Split[T ~string](v T, noSplit ...T) []T {
+	// NOTE: For plain strings, there's no need to convert the words from (and to) a string.
+	if words, ok := any(noSplit).([]string); ok {
+		retVal, _ := any(SplitAppend(make([]string, 0, EstimateWords(string(v))), string(v), words...)).([]T)
+
+		return retVal
+	}
+
+	words := make([]string, 0, len(noSplit))
+
+	for _, w := range noSplit {
+		words = append(words, string(w))
+	}
+
+	retVal := make([]T, 0, EstimateWords(string(v)))
+
+	for _, w := range SplitAppend(make([]string, 0, cap(retVal)), string(v), words...) {
+		retVal = append(retVal, T(w))
+	}
+
+	return retVal
+}
+
+// SplitAny splits v into words on delimiters (underscores, dashes, dots, slashes and whitespace characters, which are
+// dropped) and on "CamelCase" boundaries, so a string that mixes naming conventions, such as
+// "user_id-FromHTTPHeader", is split into "user", "id", "From", "HTTP" and "Header".
 // If v isn't a valid UTF-8 string, or when v is an empty string, a slice with one element (v) is returned.
 // Each word in noSplit (if provided) is treated as a word that shouldn't be split.
-func Split(v string, noSplit ...string) []string {
+func SplitAny(v string, noSplit ...string) []string {
+	return newDefaultSplitter(noSplit, nil).Split(v)
+}
+
+// SplitFunc splits v into words between each pair of adjacent runes prev and next for which boundary returns true, so
+// callers can define their own transition rules, such as splitting when a letter is followed by a digit, but not when
+// a digit is followed by a letter. No runes are dropped, so the words can be concatenated into v again.
+// A rune and the combining marks that follow it are never split, and boundary is only called for the runes (not for
+// their combining marks).
+// If v isn't a valid UTF-8 string, or when v is an empty string, a slice with one element (v) is returned.
+func SplitFunc(v string, boundary func(prev, next rune) bool) []string {
 	if !utf8.ValidString(v) || len(v) == 0 {
 		return []string{v}
 	}
 
-	vRdr := &rdr{input: v}
 	retVal := make([]string, 0)
+	prev := decodeRune(v)
+	sIdx := 0 // The position (in v) where the current word starts.
+
+	for idx := prev.size; idx < len(v); {
+		next := decodeRune(v[idx:])
+
+		if boundary(prev.r, next.r) {
+			retVal = append(retVal, v[sIdx:idx])
+			sIdx = idx
+		}
+
+		prev, idx = next, idx+next.size
+	}
+
+	return append(retVal, v[sIdx:])
+}
+
+// FirstWord returns the first word that Split returns for v, without reading the remainder of v.
+// Each word in noSplit (if provided) is treated as a word that shouldn't be split.
+func FirstWord(v string, noSplit ...string) string {
+	return NthWord(v, 0, noSplit...)
+}
+
+// LastWord returns the last word that Split returns for v, without allocating a slice to hold the words.
+// Each word in noSplit (if provided) is treated as a word that shouldn't be split.
+func LastWord(v string, noSplit ...string) string {
+	if !utf8.ValidString(v) || len(v) == 0 {
+		return v
+	}
+
+	vRdr := &rdr{input: v, noSplit: newTrie(noSplit, false)}
+	retVal := ""
+
+	for vRdr.pos < len(v) {
+		retVal = vRdr.readNextPart()
+	}
+
+	return retVal
+}
+
+// NthWord returns the n-th (zero-based) word that Split returns for v, without reading the remainder of v.
+// If there's no such word, an empty string is returned.
+// Each word in noSplit (if provided) is treated as a word that shouldn't be split.
+func NthWord(v string, n int, noSplit ...string) string {
+	if !utf8.ValidString(v) || len(v) == 0 {
+		if n == 0 {
+			return v
+		}
+
+		return ""
+	}
+
+	vRdr := &rdr{input: v, noSplit: newTrie(noSplit, false)}
+
+	for idx := 0; vRdr.pos < len(v); idx++ {
+		w := vRdr.readNextPart()
+
+		if idx == n {
+			return w
+		}
+	}
+
+	return ""
+}
+
+// WordCount returns the number of words that Split returns for v, without allocating a slice to hold them.
+// Each word in noSplit (if provided) is treated as a word that shouldn't be split.
+func WordCount(v string, noSplit ...string) int {
+	if !utf8.ValidString(v) || len(v) == 0 {
+		return 1
+	}
+
+	vRdr := &rdr{input: v, noSplit: newTrie(noSplit, false)}
+	retVal := 0
+
+	for vRdr.pos < len(v) {
+		vRdr.readNextPart()
+		retVal = retVal + 1
+	}
+
+	return retVal
+}
+
+// EstimateWords returns an estimate of the number of words that Split returns for v, without splitting v.
+// The estimate is based on the number of case transitions in v (such as a lowercase rune that's followed by an
+// uppercase rune), and is meant to be used as the capacity of a slice that holds the words of v.
+func EstimateWords(v string) int {
+	if len(v) == 0 {
+		return 1
+	}
+
+	retVal := 0
+	prev, upperRun := runeInfo{}, 0
+
+	for idx, r := range v {
+		cur := runeInfo{r: r, class: classify(r)}
+
+		switch {
+		case cur.isDigit():
+			if !prev.isDigit() {
+				retVal = retVal + 1
+			}
+
+			upperRun = 0
+		case cur.isUppercase():
+			if upperRun == 0 {
+				retVal = retVal + 1
+			}
+
+			upperRun = upperRun + 1
+		default:
+			// NOTE: A lowercase rune that follows multiple uppercase runes starts a new word (e.g. "HTMLParser").
+			if idx == 0 || upperRun > 1 || prev.isDigit() {
+				retVal = retVal + 1
+			}
+
+			upperRun = 0
+		}
+
+		prev = cur
+	}
+
+	return retVal
+}
+
+// SplitN reads v treating it as a "CamelCase" and returns (at most) n words, where the last word is the unsplit
+// remainder of v (e.g. SplitN("GetUserByID", 2) returns "Get" and "UserByID").
+// The count determines the number of words to return:
+//   - n > 0: at most n words.
+//   - n == 0: nil (zero words).
+//   - n < 0: all the words (the same as Split).
+//
+// If v isn't a valid UTF-8 string, or when v is an empty string, a slice with one element (v) is returned.
+// Each word in noSplit (if provided) is treated as a word that shouldn't be split.
+func SplitN(v string, n int, noSplit ...string) []string {
+	if n == 0 {
+		return nil
+	}
+
+	idxs := SplitIndexes(v, noSplit...)
+
+	if n > 0 && len(idxs) > n {
+		idxs[n-1][1] = len(v)
+		idxs = idxs[:n]
+	}
+
+	retVal := make([]string, 0, len(idxs))
+
+	for _, idx := range idxs {
+		retVal = append(retVal, v[idx[0]:idx[1]])
+	}
+
+	return retVal
+}
+
+// SplitAppend reads v treating it as a "CamelCase", appends the different words to dst and returns the extended slice.
+// It produces the same words as Split, but allows the caller to reuse dst (e.g. SplitAppend(dst[:0], v)).
+// If v isn't a valid UTF-8 string, or when v is an empty string, v is appended as a single element.
+// Each word in noSplit (if provided) is treated as a word that shouldn't be split.
+func SplitAppend(dst []string, v string, noSplit ...string) []string {
+	if !utf8.ValidString(v) || len(v) == 0 {
+		return append(dst, v)
+	}
+
+	vRdr := &rdr{input: v, noSplit: newTrie(noSplit, false)}
+
+	for vRdr.pos < len(v) {
+		dst = append(dst, vRdr.readNextPart())
+	}
+
+	return dst
+}
+
+// SplitIndexes reads v treating it as a "CamelCase" and returns the start and end offsets (in bytes) of the different
+// words, so that v[idx[0]:idx[1]] is a word for each idx.
+// It produces the same words as Split, but without allocating a string for each word.
+// If v isn't a valid UTF-8 string, or when v is an empty string, a slice with one element (covering v) is returned.
+// Each word in noSplit (if provided) is treated as a word that shouldn't be split.
+func SplitIndexes(v string, noSplit ...string) [][2]int {
+	if !utf8.ValidString(v) || len(v) == 0 {
+		return [][2]int{{0, len(v)}}
+	}
+
+	vRdr := &rdr{input: v, noSplit: newTrie(noSplit, false)}
+	retVal := make([][2]int, 0)
 
 	for vRdr.pos < len(v) {
-		retVal = append(retVal, vRdr.readNextPart(noSplit))
+		sIdx := vRdr.pos
+
+		vRdr.readNextPart()
+		retVal = append(retVal, [2]int{sIdx, vRdr.pos})
 	}
 
 	return retVal
 }
+
+// SplitBytes reads v treating it as a "CamelCase" and returns the different words as sub-slices of v (without copying
+// any data).
+// It produces the same words as Split. The capacity of each returned sub-slice is limited to its length, so appending
+// to a word never overwrites the rest of v.
+// If v isn't valid UTF-8, or when v is empty, a slice with one element (v) is returned.
+// Each word in noSplit (if provided) is treated as a word that shouldn't be split.
+func SplitBytes(v []byte, noSplit ...string) [][]byte {
+	if !utf8.Valid(v) || len(v) == 0 {
+		return [][]byte{v}
+	}
+
+	// NOTE: The string shares its memory with v, which is safe since it's only used for reading while splitting.
+	idxs := SplitIndexes(unsafe.String(unsafe.SliceData(v), len(v)), noSplit...)
+	retVal := make([][]byte, 0, len(idxs))
+
+	for _, idx := range idxs {
+		retVal = append(retVal, v[idx[0]:idx[1]:idx[1]])
+	}
+
+	return retVal
+}
+
+// SplitSeq returns an iterator over the words of v, treating v as a "CamelCase".
+// It produces the same words as Split, but without allocating a slice to hold them, and stops reading v as soon as the
+// caller stops iterating.
+// Each word in noSplit (if provided) is treated as a word that shouldn't be split.
+func SplitSeq(v string, noSplit ...string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		if !utf8.ValidString(v) || len(v) == 0 {
+			yield(v)
+
+			return
+		}
+
+		vRdr := &rdr{input: v, noSplit: newTrie(noSplit, false)}
+
+		for vRdr.pos < len(v) {
+			if !yield(vRdr.readNextPart()) {
+				return
+			}
+		}
+	}
+}
+
+// SplitStrict reads v treating it as a "CamelCase" and returns the different words.
+// In contrast to Split, an *InvalidUTF8Error holding the offset of the first invalid UTF-8 sequence is returned when v
+// isn't a valid UTF-8 string.
+// Each word in noSplit (if provided) is treated as a word that shouldn't be split.
+func SplitStrict(v string, noSplit ...string) ([]string, error) {
+	if idx := invalidUTF8Offset(v); idx >= 0 {
+		return nil, &InvalidUTF8Error{Offset: idx}
+	}
+
+	return Split(v, noSplit...), nil
+}
+
+// Returns the offset (in bytes) of the first invalid UTF-8 sequence in v, or -1 if v is a valid UTF-8 string.
+func invalidUTF8Offset(v string) int {
+	for idx := 0; idx < len(v); {
+		r, size := utf8.DecodeRuneInString(v[idx:])
+
+		if r == utf8.RuneError && size == 1 {
+			return idx
+		}
+
+		idx = idx + size
+	}
+
+	return -1
+}
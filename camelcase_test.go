@@ -27,8 +27,10 @@
 package camelcase_test
 
 import (
+	"errors"
 	"strings"
 	"testing"
+	"unicode"
 
 	"github.com/kdeconinck/assert"
 	"github.com/kdeconinck/camelcase"
@@ -57,6 +59,18 @@ func TestSplit(t *testing.T) {
 			vInput: "MultipleWords",
 			want:   []string{"Multiple", "Words"},
 		},
+		{
+			vInput: "2ndGeneration21stCentury",
+			want:   []string{"2nd", "Generation", "21st", "Century"},
+		},
+		{
+			vInput: "11thHour12th13thFloor3rd",
+			want:   []string{"11th", "Hour", "12th", "13th", "Floor", "3rd"},
+		},
+		{
+			vInput: "2thing1stly3RD",
+			want:   []string{"2", "thing", "1", "stly", "3", "RD"},
+		},
 		{
 			vInput: "HTML",
 			want:   []string{"HTML"},
@@ -86,6 +100,79 @@ func TestSplit(t *testing.T) {
 			vNoSplit: []string{"Tls2", "HttpCommunication"},
 			want:     []string{"1", "Tls2", "Is", "Used", "In", "HttpCommunication", "And", "Is", "Secure"},
 		},
+		{
+			vInput: "ÜberWichtig",
+			want:   []string{"Über", "Wichtig"},
+		},
+		{
+			vInput: "déjàVu",
+			want:   []string{"déjà", "Vu"},
+		},
+		{
+			vInput: "ΚαλημέραΚόσμε",
+			want:   []string{"Καλημέρα", "Κόσμε"},
+		},
+		{
+			vInput: "ПриветМир",
+			want:   []string{"Привет", "Мир"},
+		},
+		{
+			vInput: "XMLДокумент2ΩΜΕΓΑ",
+			want:   []string{"XML", "Документ", "2", "ΩΜΕΓΑ"},
+		},
+		{
+			vInput: "ÉÉÉcole",
+			want:   []string{"ÉÉ", "École"},
+		},
+		{
+			vInput: "fooǅemalǈubav",
+			want:   []string{"foo", "ǅemal", "ǈubav"},
+		},
+		{
+			vInput: "ǄǄǅemal",
+			want:   []string{"ǄǄ", "ǅemal"},
+		},
+		{
+			vInput: "E\u0301coleE\u0301le\u0301mentaire",
+			want:   []string{"E\u0301cole", "E\u0301le\u0301mentaire"},
+		},
+		{
+			vInput: "HTTPE\u0301cole",
+			want:   []string{"HTTP", "E\u0301cole"},
+		},
+		{
+			vInput: "HTTPE\u0301\u0327\u0301cole",
+			want:   []string{"HTTP", "E\u0301\u0327\u0301cole"},
+		},
+		{
+			vInput: "cafe\u0301Noir",
+			want:   []string{"cafe\u0301", "Noir"},
+		},
+		{
+			vInput: "\u0301Noir",
+			want:   []string{"\u0301", "Noir"},
+		},
+		{
+			vInput: "Version٣٢Build",
+			want:   []string{"Version", "٣٢", "Build"},
+		},
+		{
+			vInput: "संस्करण१२३Build",
+			want:   []string{"संस्करण", "१२३", "Build"},
+		},
+		{
+			vInput: "Width１２０Px",
+			want:   []string{"Width", "１２０", "Px"},
+		},
+		{
+			vInput: "٢٠٢٣Report",
+			want:   []string{"٢٠٢٣", "Report"},
+		},
+		{
+			vInput:   "ÜberWichtigSache",
+			vNoSplit: []string{"ÜberWichtig"},
+			want:     []string{"ÜberWichtig", "Sache"},
+		},
 		{
 			vInput: "BadUTF8\xe2\xe2\xa1",
 			want:   []string{"BadUTF8\xe2\xe2\xa1"},
@@ -103,6 +190,580 @@ func TestSplit(t *testing.T) {
 	}
 }
 
+// UT: Split a "CamelCase" word of a named string type into a slice of words.
+func TestSplitNamedType(t *testing.T) {
+	type fieldName string
+
+	for _, tc := range []struct {
+		vInput   fieldName
+		vNoSplit []fieldName
+		want     []fieldName
+	}{
+		{
+			vInput: "",
+			want:   []fieldName{""},
+		},
+		{
+			vInput: "UserID",
+			want:   []fieldName{"User", "ID"},
+		},
+		{
+			vInput:   "OAuth2Token",
+			vNoSplit: []fieldName{"OAuth2"},
+			want:     []fieldName{"OAuth2", "Token"},
+		},
+	} {
+		// ACT.
+		got := camelcase.Split(tc.vInput, tc.vNoSplit...)
+
+		// ASSERT.
+		assert.EqualS(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Split a \"CamelCase\" word of a named string type into a slice of words.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Split a string into a slice of words on delimiters and "CamelCase" boundaries.
+func TestSplitAny(t *testing.T) {
+	for _, tc := range []struct {
+		vInput   string
+		vNoSplit []string
+		want     []string
+	}{
+		{vInput: "", want: []string{""}},
+		{vInput: "user_id-FromHTTPHeader", want: []string{"user", "id", "From", "HTTP", "Header"}},
+		{vInput: "config.file_path  MAX_SIZE", want: []string{"config", "file", "path", "MAX", "SIZE"}},
+		{vInput: "-_. ", want: []string{}},
+		{vInput: "oauth2_OAuth2Token", vNoSplit: []string{"OAuth2"}, want: []string{"oauth", "2", "OAuth2", "Token"}},
+		{vInput: "BadUTF8\xe2\xe2\xa1_id", want: []string{"BadUTF8\xe2\xe2\xa1_id"}},
+	} {
+		// ACT.
+		got := camelcase.SplitAny(tc.vInput, tc.vNoSplit...)
+
+		// ASSERT.
+		assert.EqualS(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Split a string into a slice of words on delimiters and \"CamelCase\" boundaries.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Split a string into a slice of words using a custom boundary function.
+func TestSplitFunc(t *testing.T) {
+	letterToDigit := func(prev, next rune) bool {
+		return unicode.IsLetter(prev) && unicode.IsDigit(next)
+	}
+
+	for _, tc := range []struct {
+		vInput    string
+		vBoundary func(prev, next rune) bool
+		want      []string
+	}{
+		{vInput: "", vBoundary: letterToDigit, want: []string{""}},
+		{vInput: "utf8Decoder2go", vBoundary: letterToDigit, want: []string{"utf", "8Decoder", "2go"}},
+		{vInput: "abc", vBoundary: func(_, _ rune) bool { return true }, want: []string{"a", "b", "c"}},
+		{vInput: "abc", vBoundary: func(_, _ rune) bool { return false }, want: []string{"abc"}},
+		{
+			vInput:    "caféBar",
+			vBoundary: func(_, next rune) bool { return unicode.IsUpper(next) || next == '́' },
+			want:      []string{"café", "Bar"},
+		},
+		{vInput: "BadUTF8\xe2\xe2\xa1", vBoundary: letterToDigit, want: []string{"BadUTF8\xe2\xe2\xa1"}},
+	} {
+		// ACT.
+		got := camelcase.SplitFunc(tc.vInput, tc.vBoundary)
+
+		// ASSERT.
+		assert.EqualS(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Split a string into a slice of words using a custom boundary function.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Get the first, the last and the n-th word of a "CamelCase" string.
+func TestNthWord(t *testing.T) {
+	for _, tc := range []struct {
+		vInput    string
+		vN        int
+		vNoSplit  []string
+		wantFirst string
+		wantLast  string
+		wantNth   string
+	}{
+		{vInput: "", vN: 0, wantFirst: "", wantLast: "", wantNth: ""},
+		{vInput: "", vN: 1, wantFirst: "", wantLast: "", wantNth: ""},
+		{vInput: "lowercase", vN: 0, wantFirst: "lowercase", wantLast: "lowercase", wantNth: "lowercase"},
+		{vInput: "GetUserByID", vN: 1, wantFirst: "Get", wantLast: "ID", wantNth: "User"},
+		{vInput: "GetUserByID", vN: 3, wantFirst: "Get", wantLast: "ID", wantNth: "ID"},
+		{vInput: "GetUserByID", vN: 4, wantFirst: "Get", wantLast: "ID", wantNth: ""},
+		{vInput: "GetUserByID", vN: -1, wantFirst: "Get", wantLast: "ID", wantNth: ""},
+		{
+			vInput:    "OAuth2TokenForOAuth2",
+			vN:        2,
+			vNoSplit:  []string{"OAuth2"},
+			wantFirst: "OAuth2",
+			wantLast:  "OAuth2",
+			wantNth:   "For",
+		},
+		{
+			vInput:    "BadUTF8\xe2\xe2\xa1",
+			vN:        0,
+			wantFirst: "BadUTF8\xe2\xe2\xa1",
+			wantLast:  "BadUTF8\xe2\xe2\xa1",
+			wantNth:   "BadUTF8\xe2\xe2\xa1",
+		},
+	} {
+		// ACT.
+		gotFirst := camelcase.FirstWord(tc.vInput, tc.vNoSplit...)
+		gotLast := camelcase.LastWord(tc.vInput, tc.vNoSplit...)
+		gotNth := camelcase.NthWord(tc.vInput, tc.vN, tc.vNoSplit...)
+
+		// ASSERT.
+		assert.Equal(t, gotFirst, tc.wantFirst, "", "\n\n"+
+			"UT Name:  Get the first word of a \"CamelCase\" string.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.wantFirst, gotFirst)
+
+		assert.Equal(t, gotLast, tc.wantLast, "", "\n\n"+
+			"UT Name:  Get the last word of a \"CamelCase\" string.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.wantLast, gotLast)
+
+		assert.Equal(t, gotNth, tc.wantNth, "", "\n\n"+
+			"UT Name:  Get the n-th word of a \"CamelCase\" string.\n"+
+			"Input:    %v (n = %d)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vN, tc.wantNth, gotNth)
+	}
+}
+
+// UT: Count the number of words in a "CamelCase" string.
+func TestWordCount(t *testing.T) {
+	for _, tc := range []struct {
+		vInput   string
+		vNoSplit []string
+		want     int
+	}{
+		{vInput: "", want: 1},
+		{vInput: "lowercase", want: 1},
+		{vInput: "PDFLoader", want: 2},
+		{vInput: "GL11Version", want: 3},
+		{vInput: "HelloWorld99HTML", want: 4},
+		{vInput: "1Tls2IsUsed", vNoSplit: []string{"Tls2"}, want: 4},
+		{vInput: "BadUTF8\xe2\xe2\xa1", want: 1},
+	} {
+		// ACT.
+		got := camelcase.WordCount(tc.vInput, tc.vNoSplit...)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Count the number of words in a \"CamelCase\" string.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Estimate the number of words in a "CamelCase" string.
+func TestEstimateWords(t *testing.T) {
+	for _, tc := range []struct {
+		vInput string
+		want   int
+	}{
+		{vInput: "", want: 1},
+		{vInput: "lowercase", want: 1},
+		{vInput: "Class", want: 1},
+		{vInput: "MyClass", want: 2},
+		{vInput: "myClass", want: 2},
+		{vInput: "HTML", want: 1},
+		{vInput: "PDFLoader", want: 2},
+		{vInput: "GL11Version", want: 3},
+		{vInput: "HelloWorld99HTML", want: 4},
+		{vInput: "ÜberWichtig", want: 2},
+		{vInput: "١٢٣Test", want: 2},
+	} {
+		// ACT.
+		got := camelcase.EstimateWords(tc.vInput)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Estimate the number of words in a \"CamelCase\" string.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Split a "CamelCase" word into a slice of (at most) n words.
+func TestSplitN(t *testing.T) {
+	for _, tc := range []struct {
+		vInput   string
+		vN       int
+		vNoSplit []string
+		want     []string
+	}{
+		{
+			vInput: "GetUserByID",
+			vN:     0,
+			want:   nil,
+		},
+		{
+			vInput: "GetUserByID",
+			vN:     1,
+			want:   []string{"GetUserByID"},
+		},
+		{
+			vInput: "GetUserByID",
+			vN:     2,
+			want:   []string{"Get", "UserByID"},
+		},
+		{
+			vInput: "GetUserByID",
+			vN:     4,
+			want:   []string{"Get", "User", "By", "ID"},
+		},
+		{
+			vInput: "GetUserByID",
+			vN:     10,
+			want:   []string{"Get", "User", "By", "ID"},
+		},
+		{
+			vInput: "GetUserByID",
+			vN:     -1,
+			want:   []string{"Get", "User", "By", "ID"},
+		},
+		{
+			vInput:   "OAuth2TokenForUser",
+			vN:       2,
+			vNoSplit: []string{"OAuth2"},
+			want:     []string{"OAuth2", "TokenForUser"},
+		},
+		{
+			vInput: "",
+			vN:     2,
+			want:   []string{""},
+		},
+		{
+			vInput: "BadUTF8\xe2\xe2\xa1",
+			vN:     2,
+			want:   []string{"BadUTF8\xe2\xe2\xa1"},
+		},
+	} {
+		// ACT.
+		got := camelcase.SplitN(tc.vInput, tc.vN, tc.vNoSplit...)
+
+		// ASSERT.
+		assert.EqualS(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Split a \"CamelCase\" word into a slice of (at most) n words.\n"+
+			"Input:    %v (n = %d)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vN, tc.want, got)
+	}
+}
+
+// UT: Split a "CamelCase" word, appending the words to an existing slice.
+func TestSplitAppend(t *testing.T) {
+	for _, tc := range []struct {
+		vDst     []string
+		vInput   string
+		vNoSplit []string
+		want     []string
+	}{
+		{
+			vDst:   nil,
+			vInput: "",
+			want:   []string{""},
+		},
+		{
+			vDst:   nil,
+			vInput: "PDFLoader",
+			want:   []string{"PDF", "Loader"},
+		},
+		{
+			vDst:   []string{"Existing"},
+			vInput: "GL11Version",
+			want:   []string{"Existing", "GL", "11", "Version"},
+		},
+		{
+			vDst:     []string{"Existing"},
+			vInput:   "1Tls2IsUsed",
+			vNoSplit: []string{"Tls2"},
+			want:     []string{"Existing", "1", "Tls2", "Is", "Used"},
+		},
+		{
+			vDst:   []string{"Existing"},
+			vInput: "BadUTF8\xe2\xe2\xa1",
+			want:   []string{"Existing", "BadUTF8\xe2\xe2\xa1"},
+		},
+	} {
+		// ACT.
+		got := camelcase.SplitAppend(tc.vDst, tc.vInput, tc.vNoSplit...)
+
+		// ASSERT.
+		assert.EqualS(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Split a \"CamelCase\" word, appending the words to an existing slice.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Split a "CamelCase" word into the offsets of its words.
+func TestSplitIndexes(t *testing.T) {
+	for _, tc := range []struct {
+		vInput   string
+		vNoSplit []string
+		want     [][2]int
+	}{
+		{
+			vInput: "",
+			want:   [][2]int{{0, 0}},
+		},
+		{
+			vInput: "lowercase",
+			want:   [][2]int{{0, 9}},
+		},
+		{
+			vInput: "PDFLoader",
+			want:   [][2]int{{0, 3}, {3, 9}},
+		},
+		{
+			vInput: "GL11Version",
+			want:   [][2]int{{0, 2}, {2, 4}, {4, 11}},
+		},
+		{
+			vInput: "ÜberWichtig",
+			want:   [][2]int{{0, 5}, {5, 12}},
+		},
+		{
+			vInput:   "1Tls2IsUsed",
+			vNoSplit: []string{"Tls2"},
+			want:     [][2]int{{0, 1}, {1, 5}, {5, 7}, {7, 11}},
+		},
+		{
+			vInput: "BadUTF8\xe2\xe2\xa1",
+			want:   [][2]int{{0, 10}},
+		},
+	} {
+		// ACT.
+		got := camelcase.SplitIndexes(tc.vInput, tc.vNoSplit...)
+
+		// ASSERT.
+		assert.EqualS(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Split a \"CamelCase\" word into the offsets of its words.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Split a "CamelCase" byte slice into a slice of words.
+func TestSplitBytes(t *testing.T) {
+	for _, tc := range []struct {
+		vInput   []byte
+		vNoSplit []string
+		want     []string
+	}{
+		{
+			vInput: nil,
+			want:   []string{""},
+		},
+		{
+			vInput: []byte("PDFLoader"),
+			want:   []string{"PDF", "Loader"},
+		},
+		{
+			vInput: []byte("GL11Version"),
+			want:   []string{"GL", "11", "Version"},
+		},
+		{
+			vInput:   []byte("1Tls2IsUsed"),
+			vNoSplit: []string{"Tls2"},
+			want:     []string{"1", "Tls2", "Is", "Used"},
+		},
+		{
+			vInput: []byte("BadUTF8\xe2\xe2\xa1"),
+			want:   []string{"BadUTF8\xe2\xe2\xa1"},
+		},
+	} {
+		// ACT.
+		got := make([]string, 0)
+
+		for _, w := range camelcase.SplitBytes(tc.vInput, tc.vNoSplit...) {
+			got = append(got, string(w))
+		}
+
+		// ASSERT.
+		assert.EqualS(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Split a \"CamelCase\" byte slice into a slice of words.\n"+
+			"Input:    %s\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: The words returned by SplitBytes share their memory with the input, but can't overwrite it.
+func TestSplitBytesSharesMemory(t *testing.T) {
+	// ARRANGE.
+	input := []byte("HelloWorld")
+
+	// ACT.
+	words := camelcase.SplitBytes(input)
+	words[0][0] = 'J'
+	_ = append(words[0], '!')
+
+	// ASSERT.
+	assert.Equal(t, string(input), "JelloWorld", "", "\n\n"+
+		"UT Name:  The words returned by SplitBytes share their memory with the input, but can't overwrite it.\n"+
+		"\033[32mExpected: %v\033[0m\n"+
+		"\033[31mActual:   %v\033[0m\n\n", "JelloWorld", string(input))
+}
+
+// UT: Iterate over the words of a "CamelCase" string.
+func TestSplitSeq(t *testing.T) {
+	for _, tc := range []struct {
+		vInput   string
+		vNoSplit []string
+		vMax     int
+		want     []string
+	}{
+		{
+			vInput: "",
+			vMax:   10,
+			want:   []string{""},
+		},
+		{
+			vInput: "GL11Version",
+			vMax:   10,
+			want:   []string{"GL", "11", "Version"},
+		},
+		{
+			vInput: "GetUserByIDAndName",
+			vMax:   2,
+			want:   []string{"Get", "User"},
+		},
+		{
+			vInput:   "1Tls2IsUsed",
+			vNoSplit: []string{"Tls2"},
+			vMax:     10,
+			want:     []string{"1", "Tls2", "Is", "Used"},
+		},
+		{
+			vInput: "BadUTF8\xe2\xe2\xa1",
+			vMax:   10,
+			want:   []string{"BadUTF8\xe2\xe2\xa1"},
+		},
+	} {
+		// ACT.
+		got := make([]string, 0)
+
+		for w := range camelcase.SplitSeq(tc.vInput, tc.vNoSplit...) {
+			got = append(got, w)
+
+			if len(got) == tc.vMax {
+				break
+			}
+		}
+
+		// ASSERT.
+		assert.EqualS(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Iterate over the words of a \"CamelCase\" string.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Split a "CamelCase" word into a slice of words, failing on invalid UTF-8.
+func TestSplitStrict(t *testing.T) {
+	for _, tc := range []struct {
+		vInput   string
+		vNoSplit []string
+		want     []string
+		wantErr  string
+	}{
+		{
+			vInput: "",
+			want:   []string{""},
+		},
+		{
+			vInput: "PDFLoader",
+			want:   []string{"PDF", "Loader"},
+		},
+		{
+			vInput:   "1Tls2IsUsed",
+			vNoSplit: []string{"Tls2"},
+			want:     []string{"1", "Tls2", "Is", "Used"},
+		},
+		{
+			vInput: "ÜberWichtig",
+			want:   []string{"Über", "Wichtig"},
+		},
+		{
+			vInput:  "BadUTF8\xe2\xe2\xa1",
+			wantErr: "camelcase: invalid UTF-8 sequence at offset 7",
+		},
+		{
+			vInput:  "\xffStart",
+			wantErr: "camelcase: invalid UTF-8 sequence at offset 0",
+		},
+		{
+			vInput:  "Über\xc3",
+			wantErr: "camelcase: invalid UTF-8 sequence at offset 5",
+		},
+	} {
+		// ACT.
+		got, err := camelcase.SplitStrict(tc.vInput, tc.vNoSplit...)
+
+		// ASSERT.
+		gotErr := ""
+
+		if err != nil {
+			gotErr = err.Error()
+		}
+
+		assert.Equal(t, gotErr, tc.wantErr, "", "\n\n"+
+			"UT Name:  Split a \"CamelCase\" word into a slice of words, failing on invalid UTF-8.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.wantErr, gotErr)
+
+		assert.EqualS(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Split a \"CamelCase\" word into a slice of words, failing on invalid UTF-8.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: The error returned by SplitStrict holds the offset of the first invalid UTF-8 sequence.
+func TestSplitStrictErrorOffset(t *testing.T) {
+	// ACT.
+	_, err := camelcase.SplitStrict("Valid\x80Invalid\x80")
+
+	// ASSERT.
+	var utf8Err *camelcase.InvalidUTF8Error
+
+	ok := errors.As(err, &utf8Err)
+
+	assert.Equal(t, ok, true, "", "\n\n"+
+		"UT Name:  The error returned by SplitStrict is an *InvalidUTF8Error.\n"+
+		"\033[32mExpected: %v\033[0m\n"+
+		"\033[31mActual:   %v\033[0m\n\n", true, ok)
+
+	assert.Equal(t, utf8Err.Offset, 5, "", "\n\n"+
+		"UT Name:  The error returned by SplitStrict holds the offset of the first invalid UTF-8 sequence.\n"+
+		"\033[32mExpected: %v\033[0m\n"+
+		"\033[31mActual:   %v\033[0m\n\n", 5, utf8Err.Offset)
+}
+
 // Benchmark: Split a "CamelCase" string.
 func BenchmarkSplit(b *testing.B) {
 	// ARRANGE.
@@ -122,3 +783,85 @@ func BenchmarkSplit(b *testing.B) {
 		_ = camelcase.Split(input)
 	}
 }
+
+// Benchmark: Split a "CamelCase" string consisting of long words that shouldn't be split.
+func BenchmarkSplitLongNoSplit(b *testing.B) {
+	// ARRANGE.
+	noSplit := strings.Repeat("Ab", 1_000)
+	input := strings.Repeat(noSplit, 16)
+
+	// RESET.
+	b.ResetTimer()
+
+	// EXECUTION.
+	for i := 0; i < b.N; i++ {
+		_ = camelcase.Split(input, noSplit)
+	}
+}
+
+// Benchmark: Count the number of words in a "CamelCase" string.
+func BenchmarkWordCount(b *testing.B) {
+	// ARRANGE.
+	var s strings.Builder
+
+	for i := 0; i < 1_000; i++ {
+		s.WriteString("HelloWorld99HTML")
+	}
+
+	input := s.String()
+
+	// RESET.
+	b.ResetTimer()
+
+	// EXECUTION.
+	for i := 0; i < b.N; i++ {
+		_ = camelcase.WordCount(input)
+	}
+}
+
+// Benchmark: Split a "CamelCase" string, reusing the same slice.
+func BenchmarkSplitAppend(b *testing.B) {
+	// ARRANGE.
+	var s strings.Builder
+
+	for i := 0; i < 1_000; i++ {
+		s.WriteString("HelloWorld99HTML")
+	}
+
+	input := s.String()
+	dst := make([]string, 0)
+
+	// RESET.
+	b.ResetTimer()
+
+	// EXECUTION.
+	for i := 0; i < b.N; i++ {
+		dst = camelcase.SplitAppend(dst[:0], input)
+	}
+}
+
+// Benchmark: Iterate over the first 2 words of a "CamelCase" string.
+func BenchmarkSplitSeq(b *testing.B) {
+	// ARRANGE.
+	var s strings.Builder
+
+	for i := 0; i < 1_000; i++ {
+		s.WriteString("HelloWorld99HTML")
+	}
+
+	input := s.String()
+
+	// RESET.
+	b.ResetTimer()
+
+	// EXECUTION.
+	for i := 0; i < b.N; i++ {
+		n := 0
+
+		for range camelcase.SplitSeq(input) {
+			if n = n + 1; n == 2 {
+				break
+			}
+		}
+	}
+}
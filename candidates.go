@@ -0,0 +1,161 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import (
+	"math"
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The maximum length (in runes) of a sequence of uppercase runes for which the alternative splittings are considered.
+// A longer sequence is kept intact, since the number of alternatives doubles with each rune.
+const maxCandidateRunes = 12
+
+// The penalty (in the raw score of a candidate) for each additional word into which a sequence of uppercase runes is
+// split.
+const candidatePenalty = 0.5
+
+// A Candidate is a possible splitting of a string into words.
+type Candidate struct {
+	Words []string // The words of the string.
+	Score float64  // The confidence (between 0 and 1) that these are the correct words.
+}
+
+// SplitCandidates splits v into words (on delimiters and on "CamelCase" boundaries) and returns (at most) the n most
+// likely splittings of v, ordered from the most to the least likely one, so that tooling can present the choices or
+// pick one by policy.
+// Each sequence of uppercase runes (such as "USBC" in "USBCDevice") can also be split into multiple words. A splitting
+// scores higher when more of those runes are part of a word in acronyms (ignoring case), and lower when it uses more
+// words, so "USBCDevice" is split into "USB", "C", "Device" (rather than "USBC", "Device") when "USB" is an acronym.
+// The scores of all the possible splittings (including the ones that aren't returned) add up to 1.
+// If v isn't a valid UTF-8 string, a single candidate (v) is returned.
+func SplitCandidates(v string, n int, acronyms ...string) []Candidate {
+	if n <= 0 {
+		return make([]Candidate, 0)
+	}
+
+	if !utf8.ValidString(v) {
+		return []Candidate{{Words: []string{v}, Score: 1}}
+	}
+
+	retVal := []Candidate{{Words: make([]string, 0), Score: 1}}
+
+	for _, w := range splitWords(v, nil) {
+		retVal = combineCandidates(retVal, wordCandidates(w, acronyms), n)
+	}
+
+	return retVal
+}
+
+// Returns the alternative splittings of w, ordered from the most to the least likely one.
+// Only a sequence of uppercase runes is split into multiple words. Every other word has a single candidate (w).
+func wordCandidates(w string, acronyms []string) []Candidate {
+	offsets := make([]int, 0, maxCandidateRunes)
+
+	for idx, r := range w {
+		if !unicode.IsUpper(r) || len(offsets) == maxCandidateRunes {
+			return []Candidate{{Words: []string{w}, Score: 1}}
+		}
+
+		offsets = append(offsets, idx)
+	}
+
+	retVal := make([]Candidate, 0, 1<<(len(offsets)-1))
+	total := 0.0
+
+	// NOTE: Each bit in mask indicates whether or not a word ends after the corresponding rune.
+	for mask := 0; mask < 1<<(len(offsets)-1); mask++ {
+		words := make([]string, 0)
+		raw := 0.0
+		sIdx := 0
+
+		for idx := 1; idx <= len(offsets); idx++ {
+			if idx < len(offsets) && mask&(1<<(idx-1)) == 0 {
+				continue
+			}
+
+			eIdx := len(w)
+
+			if idx < len(offsets) {
+				eIdx = offsets[idx]
+			}
+
+			if isListed(w[sIdx:eIdx], acronyms) {
+				raw = raw + float64(utf8.RuneCountInString(w[sIdx:eIdx]))
+			}
+
+			words = append(words, w[sIdx:eIdx])
+			sIdx = eIdx
+		}
+
+		score := math.Exp(raw - candidatePenalty*float64(len(words)-1))
+		retVal = append(retVal, Candidate{Words: words, Score: score})
+		total = total + score
+	}
+
+	for idx := range retVal {
+		retVal[idx].Score = retVal[idx].Score / total
+	}
+
+	sortCandidates(retVal)
+
+	return retVal
+}
+
+// Returns (at most) the n most likely candidates that consist of the words of a candidate in heads, followed by the
+// words of a candidate in tails.
+// NOTE: Since the candidates of each word are independent, the n most likely combinations are found by combining the n
+// most likely candidates for the preceding words with each candidate of the next word.
+func combineCandidates(heads, tails []Candidate, n int) []Candidate {
+	retVal := make([]Candidate, 0, len(heads)*len(tails))
+
+	for _, head := range heads {
+		for _, tail := range tails {
+			words := make([]string, 0, len(head.Words)+len(tail.Words))
+			words = append(append(words, head.Words...), tail.Words...)
+
+			retVal = append(retVal, Candidate{Words: words, Score: head.Score * tail.Score})
+		}
+	}
+
+	sortCandidates(retVal)
+
+	return retVal[:min(n, len(retVal))]
+}
+
+// Sort candidates from the most to the least likely one.
+// Candidates that are equally likely are ordered by their number of words (fewest first).
+func sortCandidates(candidates []Candidate) {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+
+		return len(candidates[i].Words) < len(candidates[j].Words)
+	})
+}
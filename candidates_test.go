@@ -0,0 +1,83 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Split a string into the most likely candidate splittings.
+func TestSplitCandidates(t *testing.T) {
+	for _, tc := range []struct {
+		vInput    string
+		vN        int
+		vAcronyms []string
+		want      []string
+	}{
+		{vInput: "USBCDevice", vN: 0, want: []string{}},
+		{vInput: "", vN: 3, want: []string{"[] 1.00"}},
+		{vInput: "BadUTF8\xe2\xe2\xa1", vN: 3, want: []string{"[BadUTF8\xe2\xe2\xa1] 1.00"}},
+		{vInput: "ABCDEFGHIJKLMN", vN: 3, want: []string{"[ABCDEFGHIJKLMN] 1.00"}},
+		{
+			vInput: "USBCDevice",
+			vN:     3,
+			want:   []string{"[USBC Device] 0.24", "[U SBC Device] 0.15", "[US BC Device] 0.15"},
+		},
+		{
+			vInput:    "USBCDevice",
+			vN:        3,
+			vAcronyms: []string{"usb"},
+			want:      []string{"[USB C Device] 0.77", "[USBC Device] 0.06", "[U SBC Device] 0.04"},
+		},
+		{
+			vInput:    "parseHTTPSURL_id",
+			vN:        2,
+			vAcronyms: []string{"HTTP", "HTTPS", "URL", "ID"},
+			want:      []string{"[parse HTTPS URL id] 0.71", "[parse HTTP S URL id] 0.16"},
+		},
+	} {
+		// ACT.
+		candidates := camelcase.SplitCandidates(tc.vInput, tc.vN, tc.vAcronyms...)
+
+		// ASSERT.
+		got := make([]string, 0, len(candidates))
+
+		for _, c := range candidates {
+			got = append(got, fmt.Sprintf("%v %.2f", c.Words, c.Score))
+		}
+
+		assert.EqualS(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Split a string into the most likely candidate splittings.\n"+
+			"Input:    %v (n: %v, acronyms: %v)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vN, tc.vAcronyms, tc.want, got)
+	}
+}
@@ -0,0 +1,72 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// CheckWords splits v into words (on delimiters and on "CamelCase" boundaries) and returns the words that are rejected
+// by dict, such as the misspelled words of an identifier.
+// Each word is converted to lowercase before it's passed to dict, but the returned words are the words of v.
+// Numbers, and words that equal (ignoring case) a word in acronyms, are skipped. Each word in acronyms is also treated
+// as a word that shouldn't be split.
+// If v isn't a valid UTF-8 string, it's checked as a single word.
+func CheckWords(v string, dict func(word string) bool, acronyms ...string) []string {
+	retVal := make([]string, 0)
+
+	if !utf8.ValidString(v) {
+		if !dict(strings.ToLower(v)) {
+			retVal = append(retVal, v)
+		}
+
+		return retVal
+	}
+
+	for _, w := range splitWords(v, acronyms) {
+		if r, _ := utf8.DecodeRuneInString(w); isDigit(r) || isListed(w, acronyms) {
+			continue
+		}
+
+		if !dict(strings.ToLower(w)) {
+			retVal = append(retVal, w)
+		}
+	}
+
+	return retVal
+}
+
+// Checks whether or not w equals (ignoring case) a word in words.
+func isListed(w string, words []string) bool {
+	for _, v := range words {
+		if strings.EqualFold(w, v) {
+			return true
+		}
+	}
+
+	return false
+}
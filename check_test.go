@@ -0,0 +1,67 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+	"github.com/kdeconinck/slices"
+)
+
+// UT: Check the words of an identifier using a dictionary.
+func TestCheckWords(t *testing.T) {
+	// ARRANGE.
+	known := []string{"get", "user", "by", "name", "parse", "document", "version"}
+	dict := func(w string) bool { return slices.Contains(known, w) }
+
+	for _, tc := range []struct {
+		vInput    string
+		vAcronyms []string
+		want      []string
+	}{
+		{vInput: "", want: []string{}},
+		{vInput: "getUserByName", want: []string{}},
+		{vInput: "getUsreByNmae", want: []string{"Usre", "Nmae"}},
+		{vInput: "parse_HTML_document", want: []string{"HTML"}},
+		{vInput: "parse_HTML_document", vAcronyms: []string{"html"}, want: []string{}},
+		{vInput: "OAuth2Version", vAcronyms: []string{"OAuth2"}, want: []string{}},
+		{vInput: "GL11Version", want: []string{"GL"}},
+		{vInput: "BadUTF8\xe2\xe2\xa1", want: []string{"BadUTF8\xe2\xe2\xa1"}},
+	} {
+		// ACT.
+		got := camelcase.CheckWords(tc.vInput, dict, tc.vAcronyms...)
+
+		// ASSERT.
+		assert.EqualS(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Check the words of an identifier using a dictionary.\n"+
+			"Input:    %v (acronyms: %v)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vAcronyms, tc.want, got)
+	}
+}
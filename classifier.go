@@ -0,0 +1,78 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+// A Classifier classifies runes, so that the words of a string can be determined.
+// A word boundary is inserted where an uppercase rune follows a lowercase rune, where a lowercase rune follows a
+// sequence of uppercase runes (before the last uppercase rune), and around each sequence of digits. Delimiters separate
+// words and are dropped.
+// Domains with unusual alphabets (such as DNA sequences or ticker symbols) can supply their own Classifier (see
+// WithClassifier). A Classifier that embeds UnicodeClassifier only needs to implement the methods it changes.
+type Classifier interface {
+	IsUpper(r rune) bool     // Reports whether or not r is an uppercase rune, which starts a word.
+	IsLower(r rune) bool     // Reports whether or not r is a lowercase rune, which continues a word.
+	IsDigit(r rune) bool     // Reports whether or not r is a digit.
+	IsDelimiter(r rune) bool // Reports whether or not r separates words.
+}
+
+// UnicodeClassifier is the default Classifier, which classifies runes using their Unicode properties.
+// Titlecase runes (such as "ǅ") are treated as uppercase runes, and the delimiters are underscores, dashes, dots,
+// slashes and whitespace characters.
+type UnicodeClassifier struct{}
+
+// IsUpper reports whether or not r is an uppercase (or titlecase) rune.
+func (UnicodeClassifier) IsUpper(r rune) bool {
+	return classify(r)&classUpper != 0
+}
+
+// IsLower reports whether or not r is a lowercase rune.
+func (UnicodeClassifier) IsLower(r rune) bool {
+	return classify(r)&classLower != 0
+}
+
+// IsDigit reports whether or not r is a decimal digit.
+func (UnicodeClassifier) IsDigit(r rune) bool {
+	return classify(r)&classDigit != 0
+}
+
+// IsDelimiter reports whether or not r is an underscore, a dash, a dot, a slash or a whitespace character.
+func (UnicodeClassifier) IsDelimiter(r rune) bool {
+	return isDelimiter(r)
+}
+
+// Returns the classes of r, according to c.
+func classifyWith(c Classifier, r rune) uint8 {
+	switch {
+	case c.IsUpper(r):
+		return classUpper
+	case c.IsLower(r):
+		return classLower
+	case c.IsDigit(r):
+		return classDigit
+	}
+
+	return 0
+}
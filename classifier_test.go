@@ -0,0 +1,103 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// A classifier for ticker symbols, in which a dot is part of a symbol (such as "BRK.B") and commas separate symbols.
+type tickerClassifier struct {
+	camelcase.UnicodeClassifier
+}
+
+// IsUpper reports whether or not r is an uppercase rune or a dot.
+func (c tickerClassifier) IsUpper(r rune) bool {
+	return r == '.' || c.UnicodeClassifier.IsUpper(r)
+}
+
+// IsDelimiter reports whether or not r is a comma or a whitespace character.
+func (tickerClassifier) IsDelimiter(r rune) bool {
+	return r == ',' || r == ' '
+}
+
+// A classifier for DNA sequences, in which the bases "ACGT" are lowercase runes, and any other letter starts a word.
+type dnaClassifier struct {
+	camelcase.UnicodeClassifier
+}
+
+// IsUpper reports whether or not r is a letter that isn't a base.
+func (dnaClassifier) IsUpper(r rune) bool {
+	return r >= 'A' && r <= 'Z' && r != 'A' && r != 'C' && r != 'G' && r != 'T'
+}
+
+// IsLower reports whether or not r is a base.
+func (dnaClassifier) IsLower(r rune) bool {
+	return r == 'A' || r == 'C' || r == 'G' || r == 'T'
+}
+
+// UT: Split a string using a Splitter that uses a custom classifier.
+func TestSplitterClassifier(t *testing.T) {
+	for _, tc := range []struct {
+		vInput string
+		vOpts  []camelcase.Option
+		want   []string
+	}{
+		{
+			vInput: "BRK.B, AAPL",
+			vOpts:  []camelcase.Option{camelcase.WithClassifier(tickerClassifier{})},
+			want:   []string{"BRK.B", "AAPL"},
+		},
+		{
+			vInput: "BRK.B, AAPL",
+			want:   []string{"BRK", "B,", "AAPL"},
+		},
+		{
+			vInput: "XACGTNGATTACA",
+			vOpts:  []camelcase.Option{camelcase.WithClassifier(dnaClassifier{})},
+			want:   []string{"XACGT", "NGATTACA"},
+		},
+		{
+			vInput: "parseHTTPHeader_GL11Version",
+			vOpts:  []camelcase.Option{camelcase.WithClassifier(camelcase.UnicodeClassifier{})},
+			want:   []string{"parse", "HTTP", "Header", "GL", "11", "Version"},
+		},
+	} {
+		// ACT.
+		got := camelcase.New(tc.vOpts...).Split(tc.vInput)
+
+		// ASSERT.
+		assert.EqualS(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Split a string using a Splitter that uses a custom classifier.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
@@ -0,0 +1,76 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// GetterName returns the name of the getter of the field field, following the Go conventions: the name of the field
+// in "PascalCase", without a "Get" prefix, so the getter of "owner" is "Owner".
+// The common initialisms used in Go (see GoInitialisms) and each word in acronyms are written as acronyms, so the
+// getter of "user_id" is "UserID".
+// If field isn't a valid UTF-8 string, field is returned unmodified.
+func GetterName(field string, acronyms ...string) string {
+	return goNamer(acronyms).Convert(field, Pascal)
+}
+
+// SetterName returns the name of the setter of the field field, following the Go conventions: the name of the field
+// in "PascalCase", prefixed with "Set", so the setter of "owner" is "SetOwner".
+// The common initialisms used in Go (see GoInitialisms) and each word in acronyms are written as acronyms, so the
+// setter of "user_id" is "SetUserID".
+// If field isn't a valid UTF-8 string, field is returned unmodified.
+func SetterName(field string, acronyms ...string) string {
+	if !utf8.ValidString(field) {
+		return field
+	}
+
+	return "Set" + goNamer(acronyms).Convert(field, Pascal)
+}
+
+// ConstructorName returns the name of the constructor of the type typ, following the Go conventions: the name of the
+// type in "PascalCase", prefixed with "New", so the constructor of "Server" is "NewServer".
+// When typ is unexported (it doesn't start with an uppercase rune), the constructor is unexported too, so the
+// constructor of "httpClient" is "newHTTPClient".
+// The common initialisms used in Go (see GoInitialisms) and each word in acronyms are written as acronyms.
+// If typ isn't a valid UTF-8 string, typ is returned unmodified.
+func ConstructorName(typ string, acronyms ...string) string {
+	if !utf8.ValidString(typ) {
+		return typ
+	}
+
+	if r, _ := utf8.DecodeRuneInString(typ); unicode.IsUpper(r) || unicode.IsTitle(r) {
+		return "New" + goNamer(acronyms).Convert(typ, Pascal)
+	}
+
+	return "new" + goNamer(acronyms).Convert(typ, Pascal)
+}
+
+// Returns a Splitter that writes the common initialisms used in Go and each word in acronyms as acronyms.
+func goNamer(acronyms []string) *Splitter {
+	return New(WithGoInitialisms(), WithAcronyms(acronyms...))
+}
@@ -0,0 +1,94 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Generate the names of the getter and the setter of a field.
+func TestGetterSetterName(t *testing.T) {
+	for _, tc := range []struct {
+		vInput     string
+		vAcronyms  []string
+		wantGetter string
+		wantSetter string
+	}{
+		{vInput: "owner", wantGetter: "Owner", wantSetter: "SetOwner"},
+		{vInput: "userId", wantGetter: "UserID", wantSetter: "SetUserID"},
+		{vInput: "user_id", wantGetter: "UserID", wantSetter: "SetUserID"},
+		{vInput: "httpUrl", wantGetter: "HTTPURL", wantSetter: "SetHTTPURL"},
+		{vInput: "type", wantGetter: "Type", wantSetter: "SetType"},
+		{vInput: "grpc_conn", wantGetter: "GrpcConn", wantSetter: "SetGrpcConn"},
+		{vInput: "grpc_conn", vAcronyms: []string{"GRPC"}, wantGetter: "GRPCConn", wantSetter: "SetGRPCConn"},
+		{vInput: "user\xffid", wantGetter: "user\xffid", wantSetter: "user\xffid"},
+	} {
+		// ACT.
+		got := []string{
+			camelcase.GetterName(tc.vInput, tc.vAcronyms...),
+			camelcase.SetterName(tc.vInput, tc.vAcronyms...),
+		}
+
+		// ASSERT.
+		want := []string{tc.wantGetter, tc.wantSetter}
+
+		assert.EqualS(t, got, want, "", "\n\n"+
+			"UT Name:  Generate the names of the getter and the setter of a field.\n"+
+			"Input:    %v (%v)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vAcronyms, want, got)
+	}
+}
+
+// UT: Generate the name of the constructor of a type.
+func TestConstructorName(t *testing.T) {
+	for _, tc := range []struct {
+		vInput    string
+		vAcronyms []string
+		want      string
+	}{
+		{vInput: "Server", want: "NewServer"},
+		{vInput: "HttpClient", want: "NewHTTPClient"},
+		{vInput: "httpClient", want: "newHTTPClient"},
+		{vInput: "json_decoder", want: "newJSONDecoder"},
+		{vInput: "GrpcConn", vAcronyms: []string{"GRPC"}, want: "NewGRPCConn"},
+		{vInput: "Server\xff", want: "Server\xff"},
+	} {
+		// ACT.
+		got := camelcase.ConstructorName(tc.vInput, tc.vAcronyms...)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Generate the name of the constructor of a type.\n"+
+			"Input:    %v (%v)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vAcronyms, tc.want, got)
+	}
+}
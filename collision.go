@@ -0,0 +1,150 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+)
+
+// A Collision holds the distinct names that are converted into the same string.
+type Collision struct {
+	Output string   // The string into which the names are converted.
+	Inputs []string // The names that are converted into Output, in the order in which they were provided.
+}
+
+// ConvertAll converts each name in names into a string using the naming convention to (see Convert), and returns the
+// converted strings (indexed by name), together with the collisions: the distinct names that are converted into the
+// same string, such as "userId" and "userID", which are both converted into "user_id" using Snake.
+// The collisions are ordered by the first name that's converted into their output. A name that's provided multiple
+// times doesn't collide with itself.
+func ConvertAll(names []string, to Style) (map[string]string, []Collision) {
+	retVal := make(map[string]string, len(names))
+	inputs := make(map[string][]string) // The distinct names that are converted into each output.
+	outputs := make([]string, 0)        // The outputs, in the order in which they are first produced.
+
+	for _, name := range names {
+		if _, ok := retVal[name]; ok {
+			continue
+		}
+
+		output := Convert(name, to)
+		retVal[name] = output
+
+		if _, ok := inputs[output]; !ok {
+			outputs = append(outputs, output)
+		}
+
+		inputs[output] = append(inputs[output], name)
+	}
+
+	collisions := make([]Collision, 0)
+
+	for _, output := range outputs {
+		if len(inputs[output]) > 1 {
+			collisions = append(collisions, Collision{Output: output, Inputs: inputs[output]})
+		}
+	}
+
+	return retVal, collisions
+}
+
+// A Disambiguator returns a distinct string for name, which is converted into output (using the naming convention to)
+// just like n other names that precede it.
+// The result should only depend on its arguments, so collisions are resolved in the same way across runs.
+type Disambiguator func(name, output string, n int, to Style) string
+
+// NumericSuffix returns a Disambiguator that appends a number to the output, starting at 2, so "userID" becomes
+// "user_id_2" when it follows "userId" (using Snake).
+func NumericSuffix() Disambiguator {
+	return func(_, output string, n int, to Style) string {
+		return appendWord(output, strconv.Itoa(n+1), to)
+	}
+}
+
+// ContextWord returns a Disambiguator that prepends the words that context returns for the name to the output, such
+// as the name of the table a column belongs to, so "userID" becomes "orders_user_id" (using Snake).
+// When context returns an empty string, the output isn't changed.
+func ContextWord(context func(name string) string) Disambiguator {
+	return func(name, output string, _ int, to Style) string {
+		words := splitWords(context(name), nil)
+
+		if len(words) == 0 {
+			return output
+		}
+
+		return Format(append(words, splitWords(output, nil)...), to)
+	}
+}
+
+// HashSuffix returns a Disambiguator that appends the first length hexadecimal digits of the (64-bit FNV-1a) hash of
+// the name to the output, so the result doesn't depend on the order of the names: "userID" becomes "user_id_f7d1"
+// (using Snake and a length of 4).
+// The length is limited to 16 (the length of the whole hash), and a length smaller than 1 is treated as 1.
+func HashSuffix(length int) Disambiguator {
+	length = min(max(length, 1), 16)
+
+	return func(name, output string, _ int, to Style) string {
+		h := fnv.New64a()
+		h.Write([]byte(name))
+
+		return appendWord(output, fmt.Sprintf("%016x", h.Sum64())[:length], to)
+	}
+}
+
+// ConvertAllUnique converts each name in names into a string using the naming convention to (see ConvertAll), and
+// resolves the collisions using d, so that distinct names are converted into distinct strings.
+// Of the names that collide, the first one keeps its output, and d is called for the others. When the result of d is
+// empty, or still collides with another string, a number (starting at 2) is appended to it until it's distinct.
+func ConvertAllUnique(names []string, to Style, d Disambiguator) map[string]string {
+	retVal, collisions := ConvertAll(names, to)
+	taken := make(map[string]bool, len(retVal))
+
+	for _, output := range retVal {
+		taken[output] = true
+	}
+
+	for _, c := range collisions {
+		for n, name := range c.Inputs[1:] {
+			base := d(name, c.Output, n+1, to)
+			output := base
+
+			for k := 2; len(output) == 0 || taken[output]; k++ {
+				output = appendWord(base, strconv.Itoa(k), to)
+			}
+
+			retVal[name], taken[output] = output, true
+		}
+	}
+
+	return retVal
+}
+
+// Returns output (which is formatted using the naming convention to), with w appended as a separate word.
+func appendWord(output, w string, to Style) string {
+	return Format(append(splitWords(output, nil), w), to)
+}
@@ -0,0 +1,142 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Convert a set of names into a specific naming convention, detecting collisions.
+func TestConvertAll(t *testing.T) {
+	for _, tc := range []struct {
+		vInput         []string
+		vStyle         camelcase.Style
+		want           map[string]string
+		wantCollisions []camelcase.Collision
+	}{
+		{
+			vInput:         []string{},
+			vStyle:         camelcase.Snake,
+			want:           map[string]string{},
+			wantCollisions: []camelcase.Collision{},
+		},
+		{
+			vInput: []string{"userId", "createdAt", "userID", "user_id", "userId", "CreatedAt", "name"},
+			vStyle: camelcase.Snake,
+			want: map[string]string{
+				"userId": "user_id", "createdAt": "created_at", "userID": "user_id", "user_id": "user_id",
+				"CreatedAt": "created_at", "name": "name",
+			},
+			wantCollisions: []camelcase.Collision{
+				{Output: "user_id", Inputs: []string{"userId", "userID", "user_id"}},
+				{Output: "created_at", Inputs: []string{"createdAt", "CreatedAt"}},
+			},
+		},
+	} {
+		// ACT.
+		got, gotCollisions := camelcase.ConvertAll(tc.vInput, tc.vStyle)
+
+		// ASSERT.
+		// NOTE: The maps and the collisions are compared using their formatted representation.
+		assert.Equal(t, fmt.Sprint(got), fmt.Sprint(tc.want), "", "\n\n"+
+			"UT Name:  Convert a set of names into a specific naming convention, detecting collisions.\n"+
+			"Input:    %v (%v)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vStyle, tc.want, got)
+
+		assert.Equal(t, fmt.Sprint(gotCollisions), fmt.Sprint(tc.wantCollisions), "", "\n\n"+
+			"UT Name:  Convert a set of names into a specific naming convention, detecting collisions.\n"+
+			"Input:    %v (%v)\n"+
+			"\033[32mExpected (collisions): %v\033[0m\n"+
+			"\033[31mActual (collisions):   %v\033[0m\n\n", tc.vInput, tc.vStyle, tc.wantCollisions, gotCollisions)
+	}
+}
+
+// UT: Convert a set of names into a specific naming convention, resolving collisions.
+func TestConvertAllUnique(t *testing.T) {
+	// ARRANGE.
+	tables := map[string]string{"userID": "orders", "user_id": "order_items"}
+
+	for _, tc := range []struct {
+		vInput []string
+		vStyle camelcase.Style
+		vD     camelcase.Disambiguator
+		want   map[string]string
+	}{
+		{
+			vInput: []string{"userId", "userID", "user_id", "user_id_2", "name"},
+			vStyle: camelcase.Snake,
+			vD:     camelcase.NumericSuffix(),
+			want: map[string]string{
+				"userId": "user_id", "userID": "user_id_2_2", "user_id": "user_id_3", "user_id_2": "user_id_2",
+				"name": "name",
+			},
+		},
+		{
+			vInput: []string{"userId", "user_id", "user-id"},
+			vStyle: camelcase.Camel,
+			vD:     camelcase.NumericSuffix(),
+			want:   map[string]string{"userId": "userId", "user_id": "userId2", "user-id": "userId3"},
+		},
+		{
+			vInput: []string{"userId", "userID", "user_id", "UserId"},
+			vStyle: camelcase.Snake,
+			vD:     camelcase.ContextWord(func(name string) string { return tables[name] }),
+			want: map[string]string{
+				"userId": "user_id", "userID": "orders_user_id", "user_id": "order_items_user_id",
+				"UserId": "user_id_2",
+			},
+		},
+		{
+			vInput: []string{"userId", "userID"},
+			vStyle: camelcase.Snake,
+			vD:     camelcase.HashSuffix(4),
+			want:   map[string]string{"userId": "user_id", "userID": "user_id_f7d1"},
+		},
+		{
+			vInput: []string{"user_id", "userId"},
+			vStyle: camelcase.Pascal,
+			vD:     camelcase.HashSuffix(0),
+			want:   map[string]string{"user_id": "UserId", "userId": "UserIdF"},
+		},
+	} {
+		// ACT.
+		got := camelcase.ConvertAllUnique(tc.vInput, tc.vStyle, tc.vD)
+
+		// ASSERT.
+		// NOTE: The maps are compared using their formatted representation, which has sorted keys.
+		assert.Equal(t, fmt.Sprint(got), fmt.Sprint(tc.want), "", "\n\n"+
+			"UT Name:  Convert a set of names into a specific naming convention, resolving collisions.\n"+
+			"Input:    %v (%v)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vStyle, tc.want, got)
+	}
+}
@@ -0,0 +1,230 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Convert converts v into a string using the naming convention to.
+// The words are determined by splitting v on delimiters and on "CamelCase" boundaries, after which they are formatted
+// using Format. When v consists of multiple words and doesn't contain any lowercase runes (such as
+// "MY_CONSTANT_VALUE"), the words are converted to lowercase first, so they aren't formatted as acronyms.
+// If v isn't a valid UTF-8 string, v is returned unmodified.
+// Each word in noSplit (if provided) is treated as a word that shouldn't be split.
+func Convert(v string, to Style, noSplit ...string) string {
+	return newDefaultSplitter(noSplit, nil).Convert(v, to)
+}
+
+// ConvertAuto detects the naming convention v is written in (see Detect), and converts v into a string using the
+// naming convention to, so keys can be accepted in whatever naming convention they were typed.
+// When the naming convention of v is detected, a number belongs to the word it follows, since it was written as part of
+// that word, so "oauth2ClientID" becomes "oauth2_client_id" (rather than "oauth_2_client_id") using Snake.
+// When v mixes naming conventions (such as "user_id-FromHTTPHeader"), it's converted like Convert converts it.
+// If v isn't a valid UTF-8 string, v is returned unmodified.
+func ConvertAuto(v string, to Style) string {
+	if Detect(v) == Unknown {
+		return Convert(v, to)
+	}
+
+	return New(WithNumberMode(NumberAttachPrevious)).Convert(v, to)
+}
+
+// Format combines words into a single string using the naming convention to.
+// If to isn't a supported naming convention, the words are concatenated without any modification.
+func Format(words []string, to Style) string {
+	return caseMapping{}.format(words, to)
+}
+
+// Combines words into a single string using the naming convention to.
+func (c caseMapping) format(words []string, to Style) string {
+	switch to {
+	case Camel:
+		return c.join(words, "", lowerCase, upperFirstCase)
+	case Pascal:
+		return c.join(words, "", upperFirstCase, upperFirstCase)
+	case Snake:
+		return c.join(words, "_", lowerCase, lowerCase)
+	case ScreamingSnake:
+		return c.join(words, "_", upperCase, upperCase)
+	case Kebab:
+		return c.join(words, "-", lowerCase, lowerCase)
+	case Train:
+		return c.join(words, "-", upperFirstCase, upperFirstCase)
+	case Dot:
+		return c.join(words, ".", lowerCase, lowerCase)
+	case Path:
+		return c.join(words, "/", lowerCase, lowerCase)
+	case Space:
+		return c.join(words, " ", lowerCase, lowerCase)
+	case Title:
+		return c.join(words, " ", upperFirstCase, upperFirstCase)
+	}
+
+	return c.join(words, "", keepCase, keepCase)
+}
+
+// ToSnake converts v into a "snake_case" string.
+// The words are determined by splitting v on delimiters and on "CamelCase" boundaries, converted to
+// lowercase and joined using underscores.
+// If v isn't a valid UTF-8 string, v is returned unmodified.
+// Each word in noSplit (if provided) is treated as a word that shouldn't be split.
+func ToSnake(v string, noSplit ...string) string {
+	return Convert(v, Snake, noSplit...)
+}
+
+// ToScreamingSnake converts v into a "SCREAMING_SNAKE_CASE" string.
+// The words are determined by splitting v on delimiters and on "CamelCase" boundaries, converted to
+// uppercase and joined using underscores.
+// If v isn't a valid UTF-8 string, v is returned unmodified.
+// Each word in noSplit (if provided) is treated as a word that shouldn't be split.
+func ToScreamingSnake(v string, noSplit ...string) string {
+	return Convert(v, ScreamingSnake, noSplit...)
+}
+
+// ToKebab converts v into a "kebab-case" string.
+// The words are determined by splitting v on delimiters and on "CamelCase" boundaries, converted to
+// lowercase and joined using dashes.
+// If v isn't a valid UTF-8 string, v is returned unmodified.
+// Each word in noSplit (if provided) is treated as a word that shouldn't be split.
+func ToKebab(v string, noSplit ...string) string {
+	return Convert(v, Kebab, noSplit...)
+}
+
+// ToTrain converts v into a "Train-Case" string.
+// The words are determined by splitting v on delimiters and on "CamelCase" boundaries, each word
+// starts with an uppercase rune and the words are joined using dashes.
+// If v isn't a valid UTF-8 string, v is returned unmodified.
+// Each word in noSplit (if provided) is treated as a word that shouldn't be split.
+func ToTrain(v string, noSplit ...string) string {
+	return Convert(v, Train, noSplit...)
+}
+
+// ToDot converts v into a "dot.case" string.
+// The words are determined by splitting v on delimiters and on "CamelCase" boundaries, converted to
+// lowercase and joined using dots.
+// If v isn't a valid UTF-8 string, v is returned unmodified.
+// Each word in noSplit (if provided) is treated as a word that shouldn't be split.
+func ToDot(v string, noSplit ...string) string {
+	return Convert(v, Dot, noSplit...)
+}
+
+// ToPath converts v into a "path/case" string.
+// The words are determined by splitting v on delimiters and on "CamelCase" boundaries, converted to
+// lowercase and joined using slashes.
+// If v isn't a valid UTF-8 string, v is returned unmodified.
+// Each word in noSplit (if provided) is treated as a word that shouldn't be split.
+func ToPath(v string, noSplit ...string) string {
+	return Convert(v, Path, noSplit...)
+}
+
+// ToPascal converts v into a "PascalCase" string.
+// The words are determined by splitting v on delimiters and on "CamelCase" boundaries, and each word starts with an
+// uppercase rune.
+// If v isn't a valid UTF-8 string, v is returned unmodified.
+// Each word in acronyms is treated as a word that shouldn't be split, and each word that equals (ignoring case) a word
+// in acronyms is replaced by that acronym, so "html_parser" becomes "HTMLParser" when acronyms contains "HTML".
+func ToPascal(v string, acronyms ...string) string {
+	return newDefaultSplitter(acronyms, acronyms).Convert(v, Pascal)
+}
+
+// ToLowerCamel converts v into a "camelCase" string.
+// The words are determined by splitting v on delimiters and on "CamelCase" boundaries, the first word is converted to
+// lowercase and each other word starts with an uppercase rune.
+// If v isn't a valid UTF-8 string, v is returned unmodified.
+// Each word in acronyms is treated as a word that shouldn't be split, and each word (except the first one) that equals
+// (ignoring case) a word in acronyms is replaced by that acronym.
+func ToLowerCamel(v string, acronyms ...string) string {
+	return newDefaultSplitter(acronyms, acronyms).Convert(v, Camel)
+}
+
+// Humanize converts v into a human readable string, suitable for display purposes.
+// The words are determined by splitting v on delimiters and on "CamelCase" boundaries, each word that isn't an
+// acronym is converted to lowercase, the first word starts with an uppercase rune and the words are joined using
+// spaces. When v consists of multiple words and doesn't contain any lowercase runes (such as "MY_CONSTANT_VALUE"), none
+// of its words are treated as acronyms.
+// An apostrophe between 2 letters is part of a word (see WithApostrophes), so "USER'S FILE" becomes "User's file".
+// If v isn't a valid UTF-8 string, v is returned unmodified.
+func Humanize(v string) string {
+	return New(WithApostrophes()).Humanize(v)
+}
+
+// Checks whether or not w is an acronym (a word that contains at least 2 letters and no lowercase letters).
+func isAcronym(w string) bool {
+	letters := 0
+
+	for _, r := range w {
+		if unicode.IsLower(r) {
+			return false
+		}
+
+		if unicode.IsLetter(r) {
+			letters = letters + 1
+		}
+	}
+
+	return letters > 1
+}
+
+// Checks whether or not r is a rune that separates words (an underscore, a dash, a dot, a slash or a whitespace
+// character).
+func isDelimiter(r rune) bool {
+	return r == '_' || r == '-' || r == '.' || r == '/' || unicode.IsSpace(r)
+}
+
+// Split v into words.
+// v is split on each delimiter first, after which each part is split using Split.
+// Each word in noSplit (if provided) is treated as a word that shouldn't be split.
+func splitWords(v string, noSplit []string) []string {
+	return newDefaultSplitter(noSplit, nil).appendWords(make([]string, 0), v)
+}
+
+// Converts each word in words to lowercase when v consists of multiple words and doesn't contain any lowercase runes
+// (such as "MY_CONSTANT_VALUE"), since the words of such a string can't be told apart from acronyms.
+// NOTE: A single uppercase word (such as "HTML") is most likely an acronym, so it's kept intact.
+func (c caseMapping) lowerAllCaps(words []string, v string) []string {
+	if len(words) < 2 || hasLower(v) {
+		return words
+	}
+
+	for idx, w := range words {
+		words[idx] = c.toLower(w)
+	}
+
+	return words
+}
+
+// Returns the acronym in acronyms that equals (ignoring case) w, and a flag indicating if such an acronym exists.
+func findAcronym(w string, acronyms []string) (string, bool) {
+	for _, acronym := range acronyms {
+		if strings.EqualFold(w, acronym) {
+			return acronym, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,616 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Convert a string into a string using a specific naming convention.
+func TestConvert(t *testing.T) {
+	for _, tc := range []struct {
+		vInput   string
+		vStyle   camelcase.Style
+		vNoSplit []string
+		want     string
+	}{
+		{vInput: "", vStyle: camelcase.Snake, want: ""},
+		{vInput: "parseHTMLDocument2", vStyle: camelcase.Camel, want: "parseHTMLDocument2"},
+		{vInput: "parseHTMLDocument2", vStyle: camelcase.Pascal, want: "ParseHTMLDocument2"},
+		{vInput: "parseHTMLDocument2", vStyle: camelcase.Snake, want: "parse_html_document_2"},
+		{vInput: "parseHTMLDocument2", vStyle: camelcase.ScreamingSnake, want: "PARSE_HTML_DOCUMENT_2"},
+		{vInput: "parseHTMLDocument2", vStyle: camelcase.Kebab, want: "parse-html-document-2"},
+		{vInput: "parseHTMLDocument2", vStyle: camelcase.Train, want: "Parse-HTML-Document-2"},
+		{vInput: "parseHTMLDocument2", vStyle: camelcase.Dot, want: "parse.html.document.2"},
+		{vInput: "parseHTMLDocument2", vStyle: camelcase.Path, want: "parse/html/document/2"},
+		{vInput: "parseHTMLDocument2", vStyle: camelcase.Space, want: "parse html document 2"},
+		{vInput: "parseHTMLDocument2", vStyle: camelcase.Title, want: "Parse HTML Document 2"},
+		{vInput: "parse_html-document", vStyle: camelcase.Pascal, want: "ParseHtmlDocument"},
+		{vInput: "MY_CONSTANT_VALUE", vStyle: camelcase.Pascal, want: "MyConstantValue"},
+		{vInput: "MY_CONSTANT_VALUE", vStyle: camelcase.Camel, want: "myConstantValue"},
+		{vInput: "MY_CONSTANT_VALUE", vStyle: camelcase.Kebab, want: "my-constant-value"},
+		{vInput: "MAX_HTTP_SIZE", vStyle: camelcase.Title, want: "Max Http Size"},
+		{vInput: "HTML", vStyle: camelcase.Pascal, want: "HTML"},
+		{vInput: "ǆemal_ǉubav", vStyle: camelcase.Pascal, want: "ǅemalǈubav"},
+		{vInput: "ǆemal_ǉubav", vStyle: camelcase.Camel, want: "ǆemalǈubav"},
+		{vInput: "ǅemalǈubav", vStyle: camelcase.Snake, want: "ǆemal_ǉubav"},
+		{vInput: "ǅemalǈubav", vStyle: camelcase.ScreamingSnake, want: "ǄEMAL_ǇUBAV"},
+		{vInput: "parseHTMLDocument2", vStyle: camelcase.Style(-1), want: "parseHTMLDocument2"},
+		{vInput: "Tls2Config", vStyle: camelcase.Kebab, vNoSplit: []string{"Tls2"}, want: "tls2-config"},
+		{vInput: "BadUTF8\xe2\xe2\xa1", vStyle: camelcase.Snake, want: "BadUTF8\xe2\xe2\xa1"},
+	} {
+		// ACT.
+		got := camelcase.Convert(tc.vInput, tc.vStyle, tc.vNoSplit...)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Convert a string into a string using a specific naming convention.\n"+
+			"Input:    %v (%v)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vStyle, tc.want, got)
+	}
+}
+
+// UT: Convert a string into a specific naming convention, detecting its naming convention.
+func TestConvertAuto(t *testing.T) {
+	for _, tc := range []struct {
+		vInput string
+		vStyle camelcase.Style
+		want   string
+	}{
+		{vInput: "", vStyle: camelcase.Snake, want: ""},
+		{vInput: "oauth2ClientID", vStyle: camelcase.Snake, want: "oauth2_client_id"},
+		{vInput: "OAuth2ClientID", vStyle: camelcase.Kebab, want: "o-auth2-client-id"},
+		{vInput: "utf8_decoder", vStyle: camelcase.Camel, want: "utf8Decoder"},
+		{vInput: "utf8-decoder", vStyle: camelcase.Snake, want: "utf8_decoder"},
+		{vInput: "MAX_RETRIES_V2", vStyle: camelcase.Camel, want: "maxRetriesV2"},
+		{vInput: "Content-Type", vStyle: camelcase.Snake, want: "content_type"},
+		{vInput: "log.level", vStyle: camelcase.Pascal, want: "LogLevel"},
+		{vInput: "Max Size 2", vStyle: camelcase.Snake, want: "max_size_2"},
+		{vInput: "user_id-FromHTTP2Header", vStyle: camelcase.Snake, want: "user_id_from_http_2_header"},
+		{vInput: "BadUTF8\xe2\xe2\xa1", vStyle: camelcase.Snake, want: "BadUTF8\xe2\xe2\xa1"},
+	} {
+		// ACT.
+		got := camelcase.ConvertAuto(tc.vInput, tc.vStyle)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Convert a string into a specific naming convention, detecting its naming convention.\n"+
+			"Input:    %v (%v)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vStyle, tc.want, got)
+	}
+}
+
+// UT: Format a slice of words using a specific naming convention.
+func TestFormat(t *testing.T) {
+	for _, tc := range []struct {
+		vInput []string
+		vStyle camelcase.Style
+		want   string
+	}{
+		{vInput: nil, vStyle: camelcase.Pascal, want: ""},
+		{vInput: []string{"user", "ID"}, vStyle: camelcase.Camel, want: "userID"},
+		{vInput: []string{"user", "ID"}, vStyle: camelcase.Pascal, want: "UserID"},
+		{vInput: []string{"user", "ID"}, vStyle: camelcase.Snake, want: "user_id"},
+		{vInput: []string{"user", "ID"}, vStyle: camelcase.Train, want: "User-ID"},
+		{vInput: []string{"user", "ID"}, vStyle: camelcase.Title, want: "User ID"},
+	} {
+		// ACT.
+		got := camelcase.Format(tc.vInput, tc.vStyle)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Format a slice of words using a specific naming convention.\n"+
+			"Input:    %v (%v)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vStyle, tc.want, got)
+	}
+}
+
+// UT: Convert a "CamelCase" string into a "snake_case" string.
+func TestToSnake(t *testing.T) {
+	for _, tc := range []struct {
+		vInput   string
+		vNoSplit []string
+		want     string
+	}{
+		{
+			vInput: "",
+			want:   "",
+		},
+		{
+			vInput: "lowercase",
+			want:   "lowercase",
+		},
+		{
+			vInput: "MultipleWords",
+			want:   "multiple_words",
+		},
+		{
+			vInput: "PDFLoader",
+			want:   "pdf_loader",
+		},
+		{
+			vInput: "GL11Version",
+			want:   "gl_11_version",
+		},
+		{
+			vInput: "Version٣٢Build",
+			want:   "version_٣٢_build",
+		},
+		{
+			vInput: "ÜberWichtigΚόσμε",
+			want:   "über_wichtig_κόσμε",
+		},
+		{
+			vInput: "already_snake-or kebabCase",
+			want:   "already_snake_or_kebab_case",
+		},
+		{
+			vInput:   "1Tls2IsUsedInHttpCommunication",
+			vNoSplit: []string{"Tls2", "HttpCommunication"},
+			want:     "1_tls2_is_used_in_httpcommunication",
+		},
+		{
+			vInput: "BadUTF8\xe2\xe2\xa1",
+			want:   "BadUTF8\xe2\xe2\xa1",
+		},
+	} {
+		// ACT.
+		got := camelcase.ToSnake(tc.vInput, tc.vNoSplit...)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Convert a \"CamelCase\" string into a \"snake_case\" string.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Convert a "CamelCase" string into a "SCREAMING_SNAKE_CASE" string.
+func TestToScreamingSnake(t *testing.T) {
+	for _, tc := range []struct {
+		vInput   string
+		vNoSplit []string
+		want     string
+	}{
+		{
+			vInput: "",
+			want:   "",
+		},
+		{
+			vInput: "lowercase",
+			want:   "LOWERCASE",
+		},
+		{
+			vInput: "DatabaseHostName",
+			want:   "DATABASE_HOST_NAME",
+		},
+		{
+			vInput: "PDFLoader",
+			want:   "PDF_LOADER",
+		},
+		{
+			vInput: "GL11Version",
+			want:   "GL_11_VERSION",
+		},
+		{
+			vInput:   "1Tls2IsUsed",
+			vNoSplit: []string{"Tls2"},
+			want:     "1_TLS2_IS_USED",
+		},
+		{
+			vInput: "BadUTF8\xe2\xe2\xa1",
+			want:   "BadUTF8\xe2\xe2\xa1",
+		},
+	} {
+		// ACT.
+		got := camelcase.ToScreamingSnake(tc.vInput, tc.vNoSplit...)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Convert a \"CamelCase\" string into a \"SCREAMING_SNAKE_CASE\" string.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Convert a "CamelCase" string into a "kebab-case" string.
+func TestToKebab(t *testing.T) {
+	for _, tc := range []struct {
+		vInput   string
+		vNoSplit []string
+		want     string
+	}{
+		{
+			vInput: "",
+			want:   "",
+		},
+		{
+			vInput: "lowercase",
+			want:   "lowercase",
+		},
+		{
+			vInput: "MultipleWords",
+			want:   "multiple-words",
+		},
+		{
+			vInput: "PDFLoader",
+			want:   "pdf-loader",
+		},
+		{
+			vInput: "5May2000",
+			want:   "5-may-2000",
+		},
+		{
+			vInput:   "1Tls2IsUsed",
+			vNoSplit: []string{"Tls2"},
+			want:     "1-tls2-is-used",
+		},
+		{
+			vInput: "BadUTF8\xe2\xe2\xa1",
+			want:   "BadUTF8\xe2\xe2\xa1",
+		},
+	} {
+		// ACT.
+		got := camelcase.ToKebab(tc.vInput, tc.vNoSplit...)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Convert a \"CamelCase\" string into a \"kebab-case\" string.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Convert a "CamelCase" string into a "Train-Case" string.
+func TestToTrain(t *testing.T) {
+	for _, tc := range []struct {
+		vInput   string
+		vNoSplit []string
+		want     string
+	}{
+		{
+			vInput: "",
+			want:   "",
+		},
+		{
+			vInput: "lowercase",
+			want:   "Lowercase",
+		},
+		{
+			vInput: "multipleWords",
+			want:   "Multiple-Words",
+		},
+		{
+			vInput: "PDFLoader",
+			want:   "PDF-Loader",
+		},
+		{
+			vInput: "GL11Version",
+			want:   "GL-11-Version",
+		},
+		{
+			vInput: "BadUTF8\xe2\xe2\xa1",
+			want:   "BadUTF8\xe2\xe2\xa1",
+		},
+	} {
+		// ACT.
+		got := camelcase.ToTrain(tc.vInput, tc.vNoSplit...)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Convert a \"CamelCase\" string into a \"Train-Case\" string.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Convert a "CamelCase" string into a "dot.case" string.
+func TestToDot(t *testing.T) {
+	for _, tc := range []struct {
+		vInput   string
+		vNoSplit []string
+		want     string
+	}{
+		{
+			vInput: "",
+			want:   "",
+		},
+		{
+			vInput: "myFieldName",
+			want:   "my.field.name",
+		},
+		{
+			vInput: "HTTPServer_port",
+			want:   "http.server.port",
+		},
+		{
+			vInput: "server.listenAddress",
+			want:   "server.listen.address",
+		},
+		{
+			vInput: "BadUTF8\xe2\xe2\xa1",
+			want:   "BadUTF8\xe2\xe2\xa1",
+		},
+	} {
+		// ACT.
+		got := camelcase.ToDot(tc.vInput, tc.vNoSplit...)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Convert a \"CamelCase\" string into a \"dot.case\" string.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Convert a "CamelCase" string into a "path/case" string.
+func TestToPath(t *testing.T) {
+	for _, tc := range []struct {
+		vInput   string
+		vNoSplit []string
+		want     string
+	}{
+		{
+			vInput: "",
+			want:   "",
+		},
+		{
+			vInput: "myFieldName",
+			want:   "my/field/name",
+		},
+		{
+			vInput: "/users/GetUserByID",
+			want:   "users/get/user/by/id",
+		},
+		{
+			vInput:   "OAuthCallback",
+			vNoSplit: []string{"OAuth"},
+			want:     "oauth/callback",
+		},
+		{
+			vInput: "BadUTF8\xe2\xe2\xa1",
+			want:   "BadUTF8\xe2\xe2\xa1",
+		},
+	} {
+		// ACT.
+		got := camelcase.ToPath(tc.vInput, tc.vNoSplit...)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Convert a \"CamelCase\" string into a \"path/case\" string.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Convert a string into a "PascalCase" string.
+func TestToPascal(t *testing.T) {
+	for _, tc := range []struct {
+		vInput    string
+		vAcronyms []string
+		want      string
+	}{
+		{
+			vInput: "",
+			want:   "",
+		},
+		{
+			vInput: "lowercase",
+			want:   "Lowercase",
+		},
+		{
+			vInput: "multipleWords",
+			want:   "MultipleWords",
+		},
+		{
+			vInput: "PDFLoader",
+			want:   "PDFLoader",
+		},
+		{
+			vInput: "html_parser",
+			want:   "HtmlParser",
+		},
+		{
+			vInput:    "html_parser",
+			vAcronyms: []string{"HTML"},
+			want:      "HTMLParser",
+		},
+		{
+			vInput:    "user-id",
+			vAcronyms: []string{"ID"},
+			want:      "UserID",
+		},
+		{
+			vInput: "multiple  space separated words",
+			want:   "MultipleSpaceSeparatedWords",
+		},
+		{
+			vInput: "__version_2__",
+			want:   "Version2",
+		},
+		{
+			vInput: "BadUTF8\xe2\xe2\xa1",
+			want:   "BadUTF8\xe2\xe2\xa1",
+		},
+	} {
+		// ACT.
+		got := camelcase.ToPascal(tc.vInput, tc.vAcronyms...)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Convert a string into a \"PascalCase\" string.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Convert a string into a "camelCase" string.
+func TestToLowerCamel(t *testing.T) {
+	for _, tc := range []struct {
+		vInput    string
+		vAcronyms []string
+		want      string
+	}{
+		{
+			vInput: "",
+			want:   "",
+		},
+		{
+			vInput: "Uppercase",
+			want:   "uppercase",
+		},
+		{
+			vInput: "MultipleWords",
+			want:   "multipleWords",
+		},
+		{
+			vInput: "HTMLParser",
+			want:   "htmlParser",
+		},
+		{
+			vInput:    "html_parser",
+			vAcronyms: []string{"HTML"},
+			want:      "htmlParser",
+		},
+		{
+			vInput:    "parse-html",
+			vAcronyms: []string{"HTML"},
+			want:      "parseHTML",
+		},
+		{
+			vInput: "BadUTF8\xe2\xe2\xa1",
+			want:   "BadUTF8\xe2\xe2\xa1",
+		},
+	} {
+		// ACT.
+		got := camelcase.ToLowerCamel(tc.vInput, tc.vAcronyms...)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Convert a string into a \"camelCase\" string.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Convert a string into a human readable string.
+func TestHumanize(t *testing.T) {
+	for _, tc := range []struct {
+		vInput string
+		want   string
+	}{
+		{
+			vInput: "",
+			want:   "",
+		},
+		{
+			vInput: "lowercase",
+			want:   "Lowercase",
+		},
+		{
+			vInput: "numberOfRetriesHTTP",
+			want:   "Number of retries HTTP",
+		},
+		{
+			vInput: "PDFLoader",
+			want:   "PDF loader",
+		},
+		{
+			vInput: "GL11Version",
+			want:   "GL 11 version",
+		},
+		{
+			vInput: "first_name",
+			want:   "First name",
+		},
+		{
+			vInput: "MAX_RETRY_COUNT",
+			want:   "Max retry count",
+		},
+		{
+			vInput: "USER'S FILE",
+			want:   "User's file",
+		},
+		{
+			vInput: "'quoted' user's file",
+			want:   "Quoted user's file",
+		},
+		{
+			vInput: "ALetter",
+			want:   "A letter",
+		},
+		{
+			vInput: "BadUTF8\xe2\xe2\xa1",
+			want:   "BadUTF8\xe2\xe2\xa1",
+		},
+	} {
+		// ACT.
+		got := camelcase.Humanize(tc.vInput)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Convert a string into a human readable string.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// Benchmark: Convert a "CamelCase" string into a "snake_case" string.
+func BenchmarkToSnake(b *testing.B) {
+	// ARRANGE.
+	var s strings.Builder
+
+	for i := 0; i < 1_000; i++ {
+		s.WriteString("HelloWorld99HTML")
+	}
+
+	input := s.String()
+
+	// RESET.
+	b.ResetTimer()
+
+	// EXECUTION.
+	for i := 0; i < b.N; i++ {
+		_ = camelcase.ToSnake(input)
+	}
+}
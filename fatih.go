@@ -0,0 +1,90 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// The classes into which github.com/fatih/camelcase divides runes.
+const (
+	fatihLower = iota + 1 // A lowercase letter.
+	fatihUpper            // An uppercase letter.
+	fatihDigit            // A digit.
+	fatihOther            // Any other rune (such as a delimiter, a mark or a titlecase letter).
+)
+
+// Call yield for each word of v, the way github.com/fatih/camelcase's Split splits v.
+// v is divided into sequences of runes of the same class (lowercase letters, uppercase letters, digits and any other
+// runes), and the last rune of a sequence of uppercase letters is moved to the sequence of lowercase letters that
+// follows it, so "PDFLoader" is split into "PDF" and "Loader". Sequences of other runes (such as "  " or "_") are
+// words too.
+// When yield returns false, no more words are produced and false is returned.
+func eachFatihWord(v string, yield func(string) bool) bool {
+	if len(v) == 0 {
+		return true
+	}
+
+	sIdx, prevClass := 0, 0 // The position (in v) where the current word starts and the class of the previous rune.
+
+	for idx, r := range v {
+		class := fatihClass(r)
+
+		if idx > 0 && class != prevClass {
+			eIdx := idx
+
+			if prevClass == fatihUpper && class == fatihLower {
+				_, size := utf8.DecodeLastRuneInString(v[:idx])
+				eIdx = idx - size
+			}
+
+			if eIdx > sIdx && !yield(v[sIdx:eIdx]) {
+				return false
+			}
+
+			sIdx = eIdx
+		}
+
+		prevClass = class
+	}
+
+	return yield(v[sIdx:])
+}
+
+// Returns the class of r, as defined by github.com/fatih/camelcase.
+func fatihClass(r rune) int {
+	switch {
+	case unicode.IsLower(r):
+		return fatihLower
+	case unicode.IsUpper(r):
+		return fatihUpper
+	case unicode.IsDigit(r):
+		return fatihDigit
+	}
+
+	return fatihOther
+}
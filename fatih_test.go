@@ -0,0 +1,111 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Split a string in fatih compatibility mode.
+// NOTE: The expectations are the ones produced by github.com/fatih/camelcase (v1.0.0).
+func TestSplitterFatihCompat(t *testing.T) {
+	for _, tc := range []struct {
+		vInput string
+		want   []string
+	}{
+		{vInput: "", want: []string{}},
+		{vInput: "lowercase", want: []string{"lowercase"}},
+		{vInput: "Class", want: []string{"Class"}},
+		{vInput: "MyClass", want: []string{"My", "Class"}},
+		{vInput: "MyC", want: []string{"My", "C"}},
+		{vInput: "HTML", want: []string{"HTML"}},
+		{vInput: "PDFLoader", want: []string{"PDF", "Loader"}},
+		{vInput: "AString", want: []string{"A", "String"}},
+		{vInput: "SimpleXMLParser", want: []string{"Simple", "XML", "Parser"}},
+		{vInput: "vimRPCPlugin", want: []string{"vim", "RPC", "Plugin"}},
+		{vInput: "GL11Version", want: []string{"GL", "11", "Version"}},
+		{vInput: "99Bottles", want: []string{"99", "Bottles"}},
+		{vInput: "May5", want: []string{"May", "5"}},
+		{vInput: "BFG9000", want: []string{"BFG", "9000"}},
+		{vInput: "BöseÜberraschung", want: []string{"Böse", "Überraschung"}},
+		{vInput: "Two  spaces", want: []string{"Two", "  ", "spaces"}},
+		{vInput: "BadUTF8\xe2\xe2\xa1", want: []string{"BadUTF8\xe2\xe2\xa1"}},
+		{vInput: "user_id", want: []string{"user", "_", "id"}},
+		{vInput: "__init__", want: []string{"__", "init", "__"}},
+		{vInput: "A_b", want: []string{"A", "_", "b"}},
+		{vInput: "X-RayScanner", want: []string{"X", "-", "Ray", "Scanner"}},
+		{vInput: "ǅemal", want: []string{"ǅ", "emal"}},
+		{vInput: "v1Beta2", want: []string{"v", "1", "Beta", "2"}},
+	} {
+		// ACT.
+		got := camelcase.New(camelcase.WithFatihCompat()).Split(tc.vInput)
+
+		// ASSERT.
+		assert.EqualS(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Split a string in fatih compatibility mode.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Split a string in fatih compatibility mode, dropping and transforming words.
+func TestSplitterFatihCompatWithOptions(t *testing.T) {
+	// ARRANGE.
+	s := camelcase.New(
+		camelcase.WithFatihCompat(),
+		camelcase.WithStopWords("_"),
+		camelcase.WithWordTransform(func(w string) string { return w + "!" }),
+	)
+
+	// ACT.
+	got, want := s.Split("get_UserID"), []string{"get!", "User!", "ID!"}
+
+	// ASSERT.
+	assert.EqualS(t, got, want, "", "\n\n"+
+		"UT Name:  Split a string in fatih compatibility mode, dropping and transforming words.\n"+
+		"Input:    %v\n"+
+		"\033[32mExpected: %v\033[0m\n"+
+		"\033[31mActual:   %v\033[0m\n\n", "get_UserID", want, got)
+}
+
+// Benchmark: Split a string in fatih compatibility mode.
+func BenchmarkSplitterFatihCompat(b *testing.B) {
+	// ARRANGE.
+	s := camelcase.New(camelcase.WithFatihCompat())
+
+	// RESET.
+	b.ResetTimer()
+
+	// EXECUTION.
+	for i := 0; i < b.N; i++ {
+		_ = s.Split("SimpleXMLParser_GL11Version")
+	}
+}
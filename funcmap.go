@@ -0,0 +1,49 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+// FuncMap returns the conversions of this package as template functions, so they can be used directly in the
+// templates of a code generator: {{ toSnake .Name }} or {{ toPascal .Name "HTML" }}.
+// The returned map can be passed to the Funcs method of a "text/template" or an "html/template" template, and holds:
+//   - "toSnake", "toScreamingSnake", "toKebab" (see ToSnake, ToScreamingSnake and ToKebab).
+//   - "toCamel" and "toPascal" (see ToLowerCamel and ToPascal).
+//   - "humanize", "titleize" and "slug" (see Humanize, Titleize and Slug).
+//   - "split" (see Split).
+//
+// A new map is returned by each call, so it can be modified safely.
+func FuncMap() map[string]any {
+	return map[string]any{
+		"toSnake":          ToSnake,
+		"toScreamingSnake": ToScreamingSnake,
+		"toKebab":          ToKebab,
+		"toCamel":          ToLowerCamel,
+		"toPascal":         ToPascal,
+		"humanize":         Humanize,
+		"titleize":         Titleize,
+		"slug":             Slug,
+		"split":            Split[string],
+	}
+}
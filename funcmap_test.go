@@ -0,0 +1,97 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Use the conversions in a "text/template" template.
+func TestFuncMap(t *testing.T) {
+	for _, tc := range []struct {
+		vTemplate string
+		want      string
+	}{
+		{vTemplate: `{{ toSnake . }}`, want: "http_server_config"},
+		{vTemplate: `{{ toScreamingSnake . }}`, want: "HTTP_SERVER_CONFIG"},
+		{vTemplate: `{{ toKebab . }}`, want: "http-server-config"},
+		{vTemplate: `{{ toCamel . }}`, want: "httpServerConfig"},
+		{vTemplate: `{{ toPascal . }}`, want: "HttpServerConfig"},
+		{vTemplate: `{{ toPascal . "HTTP" }}`, want: "HTTPServerConfig"},
+		{vTemplate: `{{ humanize . }}`, want: "Http server config"},
+		{vTemplate: `{{ titleize . }}`, want: "Http Server Config"},
+		{vTemplate: `{{ slug . }}`, want: "http-server-config"},
+		{vTemplate: `{{ range split . }}[{{ . }}]{{ end }}`, want: "[http_server_config]"},
+		{vTemplate: `{{ range split "HttpServer" }}[{{ . }}]{{ end }}`, want: "[Http][Server]"},
+	} {
+		// ARRANGE.
+		var sb strings.Builder
+
+		tmpl := template.Must(template.New("").Funcs(camelcase.FuncMap()).Parse(tc.vTemplate))
+
+		// ACT.
+		err := tmpl.Execute(&sb, "http_server_config")
+		got := sb.String()
+
+		// ASSERT.
+		assert.Equal(t, err, nil, "", "\n\n"+
+			"UT Name:  Use the conversions in a \"text/template\" template.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vTemplate, nil, err)
+
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Use the conversions in a \"text/template\" template.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vTemplate, tc.want, got)
+	}
+}
+
+// UT: Use the conversions in an "html/template" template.
+func TestFuncMapHTML(t *testing.T) {
+	// ARRANGE.
+	var sb strings.Builder
+
+	tmpl := htmltemplate.Must(htmltemplate.New("").Funcs(camelcase.FuncMap()).Parse(`<h1>{{ titleize . }}</h1>`))
+
+	// ACT.
+	err := tmpl.Execute(&sb, "listOfUsers")
+	got := sb.String()
+
+	// ASSERT.
+	assert.Equal(t, got, "<h1>List of Users</h1>", "", "\n\n"+
+		"UT Name:  Use the conversions in an \"html/template\" template.\n"+
+		"\033[32mExpected: %v\033[0m\n"+
+		"\033[31mActual:   %v (%v)\033[0m\n\n", "<h1>List of Users</h1>", got, err)
+}
@@ -1,7 +1,9 @@
 module github.com/kdeconinck/camelcase
 
-go 1.21.0
+go 1.23.0
 
 require github.com/kdeconinck/assert v1.0.0
 
 require github.com/kdeconinck/slices v0.0.0-20230907084659-450b4d5f23dc
+
+require golang.org/x/text v0.21.0
@@ -0,0 +1,309 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import (
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The scores that are awarded when matching a pattern (see Score).
+const (
+	scoreMatch       = 1 // For each rune of the pattern.
+	scoreSameCase    = 1 // For each rune of the pattern that has the same case as the rune it matches.
+	scoreConsecutive = 4 // For each rune of the pattern that continues a word.
+	scoreWordStart   = 8 // For each rune of the pattern that starts a word.
+	scoreFirstWord   = 4 // When the first rune of the pattern starts the first word.
+	penaltySkip      = 2 // For each word that's skipped.
+)
+
+// MatchHumps reports whether or not pattern matches candidate using IDE-style "CamelHumps" matching, so "FoB" matches
+// "FooBar" and "gUbI" matches "getUserByID".
+// The candidate is split into words (see Split), and each rune of pattern (ignoring case) either continues the current
+// word, or starts one of the next words. Words may be skipped, and the first rune of pattern may start any word.
+// An uppercase rune in pattern only continues a word when the corresponding rune in the word is uppercase as well, so
+// "HTML" matches "HTMLParser", but "FOB" doesn't match "FooBar".
+// An empty pattern matches every candidate.
+func MatchHumps(pattern, candidate string) bool {
+	return newHumpMatcher(pattern, candidate).match(0, -1, 0)
+}
+
+// Score returns how well pattern matches candidate using "CamelHumps" matching (see MatchHumps), or -1 if pattern
+// doesn't match candidate.
+// The score rewards runes that start a word (especially the first word), runes that continue a word and runes that
+// have the same case, and penalizes skipped words. When pattern can be matched in multiple ways, the highest score is
+// returned.
+func Score(pattern, candidate string) int {
+	return newHumpMatcher(pattern, candidate).score(0, -1, 0)
+}
+
+// MatchSpans returns the start and end offsets (in bytes) of the runes of candidate that are matched by pattern (see
+// MatchHumps), so that a UI can highlight them. Consecutive matched runes are combined into a single span.
+// When pattern can be matched in multiple ways, the spans of the match with the highest score (see Score) are
+// returned.
+// If pattern doesn't match candidate, nil is returned.
+func MatchSpans(pattern, candidate string) [][2]int {
+	m := newHumpMatcher(pattern, candidate)
+
+	if m.score(0, -1, 0) < 0 {
+		return nil
+	}
+
+	retVal := make([][2]int, 0)
+
+	for pIdx, wIdx, cIdx := 0, -1, 0; pIdx < len(m.pattern); pIdx++ {
+		wIdx, cIdx = m.bestStep(pIdx, wIdx, cIdx)
+
+		w := m.words[wIdx]
+		sIdx := w.offs[cIdx-1]
+		eIdx := sIdx + utf8.RuneLen(w.runes[cIdx-1])
+
+		if n := len(retVal); n > 0 && retVal[n-1][1] == sIdx {
+			retVal[n-1][1] = eIdx
+		} else {
+			retVal = append(retVal, [2]int{sIdx, eIdx})
+		}
+	}
+
+	return retVal
+}
+
+// Rank returns the candidates that pattern matches (see MatchHumps), ordered from the best to the worst match.
+// Candidates with the same score are ordered by length (shortest first), and then by comparing their bytes.
+func Rank(pattern string, candidates []string) []string {
+	type match struct {
+		candidate string
+		score     int
+	}
+
+	matches := make([]match, 0, len(candidates))
+
+	for _, c := range candidates {
+		if score := Score(pattern, c); score >= 0 {
+			matches = append(matches, match{c, score})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+
+		switch {
+		case a.score != b.score:
+			return a.score > b.score
+		case len(a.candidate) != len(b.candidate):
+			return len(a.candidate) < len(b.candidate)
+		}
+
+		return a.candidate < b.candidate
+	})
+
+	retVal := make([]string, 0, len(matches))
+
+	for _, m := range matches {
+		retVal = append(retVal, m.candidate)
+	}
+
+	return retVal
+}
+
+// A word of a candidate, used when matching a pattern.
+type humpWord struct {
+	runes []rune // The runes of the word.
+	offs  []int  // The offset (in bytes) of each rune of the word in the candidate.
+}
+
+// Matches a pattern against the words of a candidate.
+type humpMatcher struct {
+	pattern []rune
+	words   []humpWord
+	failed  map[[3]int]bool // The states (see match) from which the remainder of the pattern can't be matched.
+	scores  map[[3]int]int  // The highest score (see score) that can be obtained from each state.
+}
+
+// Returns a humpMatcher that matches pattern against the words of candidate.
+func newHumpMatcher(pattern, candidate string) *humpMatcher {
+	m := &humpMatcher{pattern: []rune(pattern), failed: make(map[[3]int]bool), scores: make(map[[3]int]int)}
+
+	for _, idx := range SplitIndexes(candidate) {
+		w := humpWord{}
+
+		for off, r := range candidate[idx[0]:idx[1]] {
+			w.runes = append(w.runes, r)
+			w.offs = append(w.offs, idx[0]+off)
+		}
+
+		m.words = append(m.words, w)
+	}
+
+	return m
+}
+
+// Checks whether or not the pattern (starting at pIdx) matches the words, when the first cIdx runes of the word at wIdx
+// are already matched (wIdx is -1 when no word is matched yet).
+func (m *humpMatcher) match(pIdx, wIdx, cIdx int) bool {
+	if pIdx == len(m.pattern) {
+		return true
+	}
+
+	state := [3]int{pIdx, wIdx, cIdx}
+
+	if m.failed[state] {
+		return false
+	}
+
+	if m.canContinue(pIdx, wIdx, cIdx) && m.match(pIdx+1, wIdx, cIdx+1) {
+		return true
+	}
+
+	for next := wIdx + 1; next < len(m.words); next++ {
+		if m.canStart(pIdx, next) && m.match(pIdx+1, next, 1) {
+			return true
+		}
+	}
+
+	m.failed[state] = true
+
+	return false
+}
+
+// Returns the highest score that can be obtained by matching the pattern (starting at pIdx) against the words, when the
+// first cIdx runes of the word at wIdx are already matched (wIdx is -1 when no word is matched yet), or -1 if the
+// pattern can't be matched.
+func (m *humpMatcher) score(pIdx, wIdx, cIdx int) int {
+	if pIdx == len(m.pattern) {
+		return 0
+	}
+
+	state := [3]int{pIdx, wIdx, cIdx}
+
+	if retVal, ok := m.scores[state]; ok {
+		return retVal
+	}
+
+	retVal := -1
+
+	m.eachStep(pIdx, wIdx, cIdx, func(next, nIdx, score int) {
+		retVal = max(retVal, score)
+	})
+
+	m.scores[state] = retVal
+
+	return retVal
+}
+
+// Returns the word that the rune at pIdx in the pattern matches in the match with the highest score (when the first
+// cIdx runes of the word at wIdx are already matched), and the number of runes of that word that are matched
+// afterwards.
+func (m *humpMatcher) bestStep(pIdx, wIdx, cIdx int) (int, int) {
+	best, bestWIdx, bestCIdx := -1, -1, -1
+
+	m.eachStep(pIdx, wIdx, cIdx, func(next, nIdx, score int) {
+		if score > best {
+			best, bestWIdx, bestCIdx = score, next, nIdx
+		}
+	})
+
+	return bestWIdx, bestCIdx
+}
+
+// Call fn for each way in which the rune at pIdx in the pattern can be matched (when the first cIdx runes of the word
+// at wIdx are already matched), after which the remainder of the pattern can be matched as well.
+// The function receives the matched word, the number of runes of that word that are matched afterwards and the highest
+// score that can be obtained.
+func (m *humpMatcher) eachStep(pIdx, wIdx, cIdx int, fn func(wIdx, cIdx, score int)) {
+	if m.canContinue(pIdx, wIdx, cIdx) {
+		if rest := m.score(pIdx+1, wIdx, cIdx+1); rest >= 0 {
+			fn(wIdx, cIdx+1, rest+m.runeScore(pIdx, wIdx, cIdx)+scoreConsecutive)
+		}
+	}
+
+	for next := wIdx + 1; next < len(m.words); next++ {
+		if !m.canStart(pIdx, next) {
+			continue
+		}
+
+		if rest := m.score(pIdx+1, next, 1); rest >= 0 {
+			bonus := scoreWordStart
+
+			if next == 0 {
+				bonus = bonus + scoreFirstWord
+			}
+
+			// NOTE: The words before the first matched word aren't penalized, since the pattern may start at any word.
+			if wIdx >= 0 {
+				bonus = bonus - penaltySkip*(next-wIdx-1)
+			}
+
+			fn(next, 1, rest+m.runeScore(pIdx, next, 0)+bonus)
+		}
+	}
+}
+
+// Returns the score for matching the rune at pIdx in the pattern against the rune at cIdx in the word at wIdx.
+func (m *humpMatcher) runeScore(pIdx, wIdx, cIdx int) int {
+	if m.pattern[pIdx] == m.words[wIdx].runes[cIdx] {
+		return scoreMatch + scoreSameCase
+	}
+
+	return scoreMatch
+}
+
+// Checks whether or not the rune at pIdx in the pattern continues the word at wIdx, when the first cIdx runes of that
+// word are already matched.
+func (m *humpMatcher) canContinue(pIdx, wIdx, cIdx int) bool {
+	if wIdx < 0 || cIdx >= len(m.words[wIdx].runes) {
+		return false
+	}
+
+	p, r := m.pattern[pIdx], m.words[wIdx].runes[cIdx]
+
+	return equalFoldRune(p, r) && (!unicode.IsUpper(p) || unicode.IsUpper(r))
+}
+
+// Checks whether or not the rune at pIdx in the pattern starts the word at wIdx.
+func (m *humpMatcher) canStart(pIdx, wIdx int) bool {
+	return len(m.words[wIdx].runes) > 0 && equalFoldRune(m.pattern[pIdx], m.words[wIdx].runes[0])
+}
+
+// Checks whether or not a and b are equal under Unicode case-folding.
+func equalFoldRune(a, b rune) bool {
+	if a == b {
+		return true
+	}
+
+	if a < utf8.RuneSelf && b < utf8.RuneSelf {
+		return unicode.ToLower(a) == unicode.ToLower(b)
+	}
+
+	for f := unicode.SimpleFold(a); f != a; f = unicode.SimpleFold(f) {
+		if f == b {
+			return true
+		}
+	}
+
+	return false
+}
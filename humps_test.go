@@ -0,0 +1,164 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Match a pattern against a candidate using "CamelHumps" matching.
+func TestMatchHumps(t *testing.T) {
+	for _, tc := range []struct {
+		vPattern   string
+		vCandidate string
+		want       bool
+	}{
+		{vPattern: "", vCandidate: "FooBar", want: true},
+		{vPattern: "FoB", vCandidate: "FooBar", want: true},
+		{vPattern: "FB", vCandidate: "FooBar", want: true},
+		{vPattern: "fb", vCandidate: "FooBar", want: true},
+		{vPattern: "FooBar", vCandidate: "FooBar", want: true},
+		{vPattern: "FOB", vCandidate: "FooBar", want: false},
+		{vPattern: "FBa", vCandidate: "FooBar", want: true},
+		{vPattern: "FBx", vCandidate: "FooBar", want: false},
+		{vPattern: "oB", vCandidate: "FooBar", want: false},
+		{vPattern: "Bar", vCandidate: "FooBar", want: true},
+		{vPattern: "gUbI", vCandidate: "getUserByID", want: true},
+		{vPattern: "gubi", vCandidate: "getUserByID", want: true},
+		{vPattern: "getID", vCandidate: "getUserByID", want: true},
+		{vPattern: "gIU", vCandidate: "getUserByID", want: false},
+		{vPattern: "HTML", vCandidate: "HTMLParser", want: true},
+		{vPattern: "HTMLP", vCandidate: "HTMLParser", want: true},
+		{vPattern: "NPE", vCandidate: "NullPointerException", want: true},
+		{vPattern: "üWi", vCandidate: "ÜberWichtig", want: true},
+		{vPattern: "FooBarBaz", vCandidate: "FooBar", want: false},
+	} {
+		// ACT.
+		got := camelcase.MatchHumps(tc.vPattern, tc.vCandidate)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Match a pattern against a candidate using \"CamelHumps\" matching.\n"+
+			"Input:    %v, %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vPattern, tc.vCandidate, tc.want, got)
+	}
+}
+
+// UT: Score how well a pattern matches a candidate.
+func TestScore(t *testing.T) {
+	for _, tc := range []struct {
+		vPattern   string
+		vCandidate string
+		want       int
+	}{
+		{vPattern: "", vCandidate: "FooBar", want: 0},
+		{vPattern: "x", vCandidate: "FooBar", want: -1},
+		{vPattern: "F", vCandidate: "FooBar", want: 14},
+		{vPattern: "f", vCandidate: "FooBar", want: 13},
+		{vPattern: "B", vCandidate: "FooBar", want: 10},
+		{vPattern: "FB", vCandidate: "FooBar", want: 24},
+		{vPattern: "FB", vCandidate: "FooBazBar", want: 24},
+		{vPattern: "FBa", vCandidate: "FooBazBar", want: 30},
+		{vPattern: "Fo", vCandidate: "FooBar", want: 20},
+	} {
+		// ACT.
+		got := camelcase.Score(tc.vPattern, tc.vCandidate)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Score how well a pattern matches a candidate.\n"+
+			"Input:    %v, %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vPattern, tc.vCandidate, tc.want, got)
+	}
+}
+
+// UT: Rank candidates by how well a pattern matches them.
+func TestRank(t *testing.T) {
+	for _, tc := range []struct {
+		vPattern    string
+		vCandidates []string
+		want        []string
+	}{
+		{
+			vPattern:    "gUBI",
+			vCandidates: []string{"getUsers", "getUserByID", "getUserByIDOrName", "GetUserBadgeIcon", "putUserByID"},
+			want:        []string{"getUserByID", "getUserByIDOrName", "GetUserBadgeIcon"},
+		},
+		{
+			vPattern:    "us",
+			vCandidates: []string{"UserService", "users", "updateStatus", "MyUserStore"},
+			want:        []string{"updateStatus", "UserService", "users", "MyUserStore"},
+		},
+		{
+			vPattern:    "",
+			vCandidates: []string{"b", "a", "ab"},
+			want:        []string{"a", "b", "ab"},
+		},
+	} {
+		// ACT.
+		got := camelcase.Rank(tc.vPattern, tc.vCandidates)
+
+		// ASSERT.
+		assert.EqualS(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Rank candidates by how well a pattern matches them.\n"+
+			"Input:    %v, %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vPattern, tc.vCandidates, tc.want, got)
+	}
+}
+
+// UT: Find the spans of the runes of a candidate that are matched by a pattern.
+func TestMatchSpans(t *testing.T) {
+	for _, tc := range []struct {
+		vPattern   string
+		vCandidate string
+		want       [][2]int
+	}{
+		{vPattern: "x", vCandidate: "FooBar", want: nil},
+		{vPattern: "", vCandidate: "FooBar", want: [][2]int{}},
+		{vPattern: "FoB", vCandidate: "FooBar", want: [][2]int{{0, 2}, {3, 4}}},
+		{vPattern: "gUbI", vCandidate: "getUserByID", want: [][2]int{{0, 1}, {3, 4}, {7, 8}, {9, 10}}},
+		{vPattern: "HTMLP", vCandidate: "HTMLParser", want: [][2]int{{0, 5}}},
+		{vPattern: "üW", vCandidate: "ÜberWichtig", want: [][2]int{{0, 2}, {5, 6}}},
+		{vPattern: "Bar", vCandidate: "FooBarBar", want: [][2]int{{3, 6}}},
+	} {
+		// ACT.
+		got := camelcase.MatchSpans(tc.vPattern, tc.vCandidate)
+
+		// ASSERT.
+		assert.EqualS(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Find the spans of the runes of a candidate that are matched by a pattern.\n"+
+			"Input:    %v, %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vPattern, tc.vCandidate, tc.want, got)
+	}
+}
@@ -0,0 +1,127 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The common prefixes used in Hungarian notation (such as "str" in "strName").
+// The prefixes that end with an underscore are scope prefixes (such as "m_" in "m_count"), which can be followed by a
+// type prefix (such as "m_pszBuffer").
+var hungarianPrefixes = []string{
+	"arr", "by", "cb", "ch", "dw", "fn", "lp", "lpsz", "psz", "pv", "str", "sz", "ui", "ul", "wsz", "g_", "m_", "s_",
+}
+
+// The common single letter prefixes used in Hungarian notation (such as "i" in "iCount").
+var hungarianLetterPrefixes = []string{"a", "b", "c", "f", "h", "i", "l", "n", "p", "u", "w"}
+
+// HungarianPrefixes returns the common prefixes used in Hungarian notation, such as "str" (in "strName"), "psz" (in
+// "pszBuffer") and the scope prefix "m_" (in "m_count").
+// The single letter prefixes (see HungarianLetterPrefixes) aren't included, since they would strip the first letter of
+// ordinary names (such as "iPhone").
+// The returned slice is a copy and can be modified safely.
+func HungarianPrefixes() []string {
+	return append([]string(nil), hungarianPrefixes...)
+}
+
+// HungarianLetterPrefixes returns the common single letter prefixes used in Hungarian notation, such as "i" (in
+// "iCount") and "b" (in "bEnabled").
+// These prefixes must be configured explicitly, such as using
+// WithHungarianPrefixes(append(HungarianPrefixes(), HungarianLetterPrefixes()...)...).
+// NOTE: A single letter prefix can't be told apart from the first letter of a name, so "iPhone" becomes "Phone" and
+// "aValue" becomes "Value" when these prefixes are used.
+// The returned slice is a copy and can be modified safely.
+func HungarianLetterPrefixes() []string {
+	return append([]string(nil), hungarianLetterPrefixes...)
+}
+
+// WithHungarianPrefixes returns an Option that strips the Hungarian prefix (see HungarianPrefix) from the start of each
+// string before it's split, so "strFileName" is split into "File" and "Name", and converted into "fileName" using
+// Camel.
+// If no prefixes are provided, the common prefixes (see HungarianPrefixes) are used.
+func WithHungarianPrefixes(prefixes ...string) Option {
+	if len(prefixes) == 0 {
+		prefixes = hungarianPrefixes
+	}
+
+	return func(s *Splitter) {
+		s.hungarian = append(s.hungarian, prefixes...)
+	}
+}
+
+// HungarianPrefix returns the Hungarian prefix of v, or an empty string if v doesn't have one.
+// The prefix consists of an (optional) scope prefix (a prefix in prefixes that ends with an underscore, such as "m_"),
+// followed by an (optional) type prefix (such as "str"), which must be followed by an uppercase rune. The longest
+// matching prefixes are used, and they are matched case-sensitively, so the prefix of "m_pszBuffer" is "m_psz", while
+// "string" doesn't have a prefix.
+// If no prefixes are provided, the common prefixes (see HungarianPrefixes) are used.
+func HungarianPrefix(v string, prefixes ...string) string {
+	if len(prefixes) == 0 {
+		prefixes = hungarianPrefixes
+	}
+
+	return v[:hungarianPrefixLen(v, prefixes)]
+}
+
+// StripHungarian returns v without its Hungarian prefix (see HungarianPrefix), so "strFileName" becomes "FileName" and
+// "m_count" becomes "count".
+// If no prefixes are provided, the common prefixes (see HungarianPrefixes) are used.
+func StripHungarian(v string, prefixes ...string) string {
+	if len(prefixes) == 0 {
+		prefixes = hungarianPrefixes
+	}
+
+	return v[hungarianPrefixLen(v, prefixes):]
+}
+
+// Returns the length (in bytes) of the Hungarian prefix of v, or 0 if v doesn't have one.
+func hungarianPrefixLen(v string, prefixes []string) int {
+	retVal := 0
+
+	// NOTE: A scope prefix must be followed by a letter, so "m_" on its own (or "m__x") isn't stripped.
+	for _, p := range prefixes {
+		if strings.HasSuffix(p, "_") && len(p) > retVal && strings.HasPrefix(v, p) {
+			if r, _ := utf8.DecodeRuneInString(v[len(p):]); unicode.IsLetter(r) {
+				retVal = len(p)
+			}
+		}
+	}
+
+	n := 0
+
+	for _, p := range prefixes {
+		if !strings.HasSuffix(p, "_") && len(p) > n && strings.HasPrefix(v[retVal:], p) {
+			if r, _ := utf8.DecodeRuneInString(v[retVal+len(p):]); unicode.IsUpper(r) {
+				n = len(p)
+			}
+		}
+	}
+
+	return retVal + n
+}
@@ -0,0 +1,133 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Get and strip the Hungarian prefix of a string.
+func TestStripHungarian(t *testing.T) {
+	for _, tc := range []struct {
+		vInput     string
+		vPrefixes  []string
+		wantPrefix string
+		want       string
+	}{
+		{vInput: "", wantPrefix: "", want: ""},
+		{vInput: "strFileName", wantPrefix: "str", want: "FileName"},
+		{vInput: "pszBuffer", wantPrefix: "psz", want: "Buffer"},
+		{vInput: "m_count", wantPrefix: "m_", want: "count"},
+		{vInput: "m_pszBuffer", wantPrefix: "m_psz", want: "Buffer"},
+		{vInput: "string", wantPrefix: "", want: "string"},
+		{vInput: "m_", wantPrefix: "", want: "m_"},
+		{vInput: "FileName", wantPrefix: "", want: "FileName"},
+		{vInput: "strFileName", vPrefixes: []string{"obj"}, wantPrefix: "", want: "strFileName"},
+		{vInput: "objFileName", vPrefixes: []string{"obj"}, wantPrefix: "obj", want: "FileName"},
+		{vInput: "iPhone", wantPrefix: "", want: "iPhone"},
+		{vInput: "bEnabled", wantPrefix: "", want: "bEnabled"},
+		{vInput: "m_iCount", wantPrefix: "m_", want: "iCount"},
+		{vInput: "iCount", vPrefixes: camelcase.HungarianLetterPrefixes(), wantPrefix: "i", want: "Count"},
+		{vInput: "iPhone", vPrefixes: camelcase.HungarianLetterPrefixes(), wantPrefix: "i", want: "Phone"},
+	} {
+		// ACT.
+		prefix := camelcase.HungarianPrefix(tc.vInput, tc.vPrefixes...)
+		got := camelcase.StripHungarian(tc.vInput, tc.vPrefixes...)
+
+		// ASSERT.
+		assert.Equal(t, prefix, tc.wantPrefix, "", "\n\n"+
+			"UT Name:  Get and strip the Hungarian prefix of a string.\n"+
+			"Input:    %v (prefixes: %v)\n"+
+			"\033[32mExpected (prefix): %v\033[0m\n"+
+			"\033[31mActual (prefix):   %v\033[0m\n\n", tc.vInput, tc.vPrefixes, tc.wantPrefix, prefix)
+
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Get and strip the Hungarian prefix of a string.\n"+
+			"Input:    %v (prefixes: %v)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vPrefixes, tc.want, got)
+	}
+}
+
+// UT: Convert a string, stripping its Hungarian prefix.
+func TestWithHungarianPrefixes(t *testing.T) {
+	for _, tc := range []struct {
+		vInput string
+		vStyle camelcase.Style
+		want   string
+	}{
+		{vInput: "strFileName", vStyle: camelcase.Pascal, want: "FileName"},
+		{vInput: "m_count", vStyle: camelcase.Camel, want: "count"},
+		{vInput: "m_pszBuffer", vStyle: camelcase.Snake, want: "buffer"},
+		{vInput: "dwMaxSize", vStyle: camelcase.Kebab, want: "max-size"},
+		{vInput: "index", vStyle: camelcase.Pascal, want: "Index"},
+		{vInput: "iPhoneCase", vStyle: camelcase.Snake, want: "i_phone_case"},
+	} {
+		// ACT.
+		got := camelcase.New(camelcase.WithHungarianPrefixes()).Convert(tc.vInput, tc.vStyle)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Convert a string, stripping its Hungarian prefix.\n"+
+			"Input:    %v (%v)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vStyle, tc.want, got)
+	}
+}
+
+// UT: Modifying the slice returned by HungarianPrefixes doesn't modify the common Hungarian prefixes.
+func TestHungarianPrefixesIsCopy(t *testing.T) {
+	// ARRANGE.
+	camelcase.HungarianPrefixes()[0] = "Changed"
+
+	// ACT.
+	got := camelcase.HungarianPrefixes()[0]
+
+	// ASSERT.
+	assert.Equal(t, got, "arr", "", "\n\n"+
+		"UT Name:  Modifying the slice returned by HungarianPrefixes doesn't modify the common Hungarian prefixes.\n"+
+		"\033[32mExpected: %v\033[0m\n"+
+		"\033[31mActual:   %v\033[0m\n\n", "arr", got)
+}
+
+// UT: Modifying the slice returned by HungarianLetterPrefixes doesn't modify the single letter Hungarian prefixes.
+func TestHungarianLetterPrefixesIsCopy(t *testing.T) {
+	// ARRANGE.
+	camelcase.HungarianLetterPrefixes()[0] = "Changed"
+
+	// ACT.
+	got := camelcase.HungarianLetterPrefixes()[0]
+
+	// ASSERT.
+	assert.Equal(t, got, "a", "", "\n\n"+
+		"UT Name:  Modifying the slice returned by HungarianLetterPrefixes doesn't modify the letter prefixes.\n"+
+		"\033[32mExpected: %v\033[0m\n"+
+		"\033[31mActual:   %v\033[0m\n\n", "a", got)
+}
@@ -0,0 +1,124 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// The ASCII spelling of the letters that don't decompose into an ASCII letter and a combining mark.
+var transliterations = map[rune]string{
+	'ß': "ss", 'ẞ': "SS", 'æ': "ae", 'Æ': "AE", 'œ': "oe", 'Œ': "OE", 'ø': "o", 'Ø': "O", 'đ': "d", 'Đ': "D",
+	'ł': "l", 'Ł': "L", 'þ': "th", 'Þ': "TH", 'ð': "d", 'Ð': "D", 'ı': "i", 'ħ': "h", 'Ħ': "H",
+}
+
+// SanitizeIdentifier converts v (such as a display name typed by a user) into an identifier, using the naming
+// convention style.
+// The words are determined by splitting v on delimiters and on "CamelCase" boundaries, after which they are
+// transliterated to ASCII where possible, so "Crème Brûlée" becomes "creme_brulee" using Snake.
+// Apostrophes are removed and each other rune that isn't a letter or a digit is treated as a delimiter (consecutive
+// delimiters are collapsed). When no words remain, or when the first word starts with a digit, the word "x" is
+// prepended, so "2FA code" becomes "x_2_fa_code" using Snake. When the result is a Go keyword or a predeclared
+// identifier, an underscore is appended (see WithGoKeywordEscape), so "type" becomes "type_" using Snake.
+// NOTE: The result is only a valid identifier when style joins its words without a delimiter or with underscores
+// (Camel, Pascal, Snake and ScreamingSnake). Letters without an ASCII spelling are kept, since they're valid in Go
+// identifiers.
+func SanitizeIdentifier(v string, style Style) string {
+	words := sanitizedWords(v, func(r rune) bool { return unicode.IsLetter(r) || isDigit(r) })
+
+	if len(words) == 0 || isDigit(firstRune(words[0])) {
+		words = append([]string{"x"}, words...)
+	}
+
+	return New(WithGoKeywordEscape(nil)).escapeReserved(Format(words, style))
+}
+
+// Returns the words of v (transliterated to ASCII where possible) that consist of runes accepted by keep.
+// Apostrophes are removed, each other rune that isn't a letter, a digit or a combining mark separates 2 words, and
+// each rune that isn't accepted by keep (after transliteration) separates 2 words too.
+// NOTE: The words are transliterated after v is split, so a letter such as "Æ" doesn't introduce a "CamelCase"
+// boundary.
+func sanitizedWords(v string, keep func(r rune) bool) []string {
+	var sb strings.Builder
+
+	for _, r := range norm.NFKC.String(strings.ToValidUTF8(v, " ")) {
+		switch {
+		case r == '\'' || r == '’':
+			continue
+		case unicode.IsLetter(r) || isDigit(r) || isMark(r):
+			sb.WriteRune(r)
+		default:
+			sb.WriteByte(' ')
+		}
+	}
+
+	v = sb.String()
+	retVal := make([]string, 0)
+
+	for _, w := range splitWords(v, nil) {
+		retVal = append(retVal, strings.FieldsFunc(transliterate(w), func(r rune) bool { return !keep(r) })...)
+	}
+
+	return caseMapping{}.lowerAllCaps(retVal, v)
+}
+
+// Returns the word w, with each letter that has an ASCII spelling replaced by that spelling (such as "é" by "e").
+// Combining marks are removed. A letter that's spelled using multiple uppercase letters (such as "Æ") is spelled using
+// an uppercase letter followed by lowercase letters (such as "Ae") when w contains lowercase letters.
+func transliterate(w string) string {
+	var sb strings.Builder
+
+	for _, r := range norm.NFKD.String(w) {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+
+		if t, ok := transliterations[r]; ok {
+			if len(t) > 1 && !hasLower(t) && hasLower(w) {
+				t = t[:1] + strings.ToLower(t[1:])
+			}
+
+			sb.WriteString(t)
+
+			continue
+		}
+
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
+
+// Returns the first rune of v.
+func firstRune(v string) rune {
+	r, _ := utf8.DecodeRuneInString(v)
+
+	return r
+}
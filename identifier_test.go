@@ -0,0 +1,122 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Convert an arbitrary string into an identifier.
+func TestSanitizeIdentifier(t *testing.T) {
+	for _, tc := range []struct {
+		vInput string
+		vStyle camelcase.Style
+		want   string
+	}{
+		{vInput: "", vStyle: camelcase.Snake, want: "x"},
+		{vInput: "!?", vStyle: camelcase.Pascal, want: "X"},
+		{vInput: "First name", vStyle: camelcase.Snake, want: "first_name"},
+		{vInput: "First name", vStyle: camelcase.Pascal, want: "FirstName"},
+		{vInput: "  E-mail   address (work) ", vStyle: camelcase.Camel, want: "eMailAddressWork"},
+		{vInput: "User's name", vStyle: camelcase.Snake, want: "users_name"},
+		{vInput: "Crème Brûlée", vStyle: camelcase.Snake, want: "creme_brulee"},
+		{vInput: "Straße", vStyle: camelcase.Pascal, want: "Strasse"},
+		{vInput: "2FA code", vStyle: camelcase.Snake, want: "x_2_fa_code"},
+		{vInput: "2FA code", vStyle: camelcase.Pascal, want: "X2FACode"},
+		{vInput: "Price ($)", vStyle: camelcase.ScreamingSnake, want: "PRICE"},
+		{vInput: "Customer ID", vStyle: camelcase.Pascal, want: "CustomerID"},
+		{vInput: "__private__field", vStyle: camelcase.Snake, want: "private_field"},
+		{vInput: "größe\xffwert", vStyle: camelcase.Snake, want: "grosse_wert"},
+		{vInput: "Δείκτης", vStyle: camelcase.Pascal, want: "Δεικτης"},
+		{vInput: "Æsir", vStyle: camelcase.Snake, want: "aesir"},
+		{vInput: "Þórr", vStyle: camelcase.Pascal, want: "Thorr"},
+		{vInput: "ÆsirÞórr", vStyle: camelcase.Camel, want: "aesirThorr"},
+		{vInput: "ŒUVRE ÉTÉ", vStyle: camelcase.Pascal, want: "OeuvreEte"},
+		{vInput: "2fa token", vStyle: camelcase.Camel, want: "x2FaToken"},
+		{vInput: "42", vStyle: camelcase.Camel, want: "x42"},
+		{vInput: "type", vStyle: camelcase.Snake, want: "type_"},
+		{vInput: "func", vStyle: camelcase.Camel, want: "func_"},
+		{vInput: "String", vStyle: camelcase.Camel, want: "string_"},
+		{vInput: "type", vStyle: camelcase.Pascal, want: "Type"},
+		{vInput: "Type name", vStyle: camelcase.Snake, want: "type_name"},
+	} {
+		// ACT.
+		got := camelcase.SanitizeIdentifier(tc.vInput, tc.vStyle)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Convert an arbitrary string into an identifier.\n"+
+			"Input:    %v (%v)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vStyle, tc.want, got)
+	}
+}
+
+// UT: Convert an arbitrary string into a valid Go identifier.
+func TestSanitizeIdentifierIsValid(t *testing.T) {
+	for _, tc := range []struct {
+		vInput string
+		vStyle camelcase.Style
+	}{
+		{vInput: "", vStyle: camelcase.Camel},
+		{vInput: "2fa token", vStyle: camelcase.Camel},
+		{vInput: "2fa token", vStyle: camelcase.Pascal},
+		{vInput: "2fa token", vStyle: camelcase.Snake},
+		{vInput: "2fa token", vStyle: camelcase.ScreamingSnake},
+		{vInput: "٣ items", vStyle: camelcase.Camel},
+		{vInput: "- 42 -", vStyle: camelcase.Snake},
+		{vInput: "Type", vStyle: camelcase.Camel},
+		{vInput: "range", vStyle: camelcase.Snake},
+		{vInput: "Crème Brûlée (2nd serving)", vStyle: camelcase.Pascal},
+		{vInput: "e\u0301tat", vStyle: camelcase.Camel},
+		{vInput: "\xff\xfe", vStyle: camelcase.Camel},
+	} {
+		// ACT.
+		got := camelcase.SanitizeIdentifier(tc.vInput, tc.vStyle)
+
+		// ASSERT.
+		assert.Equal(t, camelcase.IsValidGoIdentifier(got), true, "", "\n\n"+
+			"UT Name:  Convert an arbitrary string into a valid Go identifier.\n"+
+			"Input:    %v (%v)\n"+
+			"\033[32mExpected: A valid Go identifier\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vStyle, got)
+	}
+}
+
+// Benchmark: Convert an arbitrary string into an identifier.
+func BenchmarkSanitizeIdentifier(b *testing.B) {
+	// RESET.
+	b.ResetTimer()
+
+	// EXECUTION.
+	for i := 0; i < b.N; i++ {
+		_ = camelcase.SanitizeIdentifier("Crème Brûlée (2nd serving)", camelcase.Snake)
+	}
+}
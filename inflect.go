@@ -0,0 +1,166 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import "strings"
+
+// The English nouns that have the same singular and plural form.
+var uncountableNouns = []string{
+	"data", "deer", "equipment", "feedback", "fish", "information", "metadata", "money", "news", "rice", "series",
+	"sheep", "software", "species",
+}
+
+// The English nouns that aren't inflected using the regular rules, as pairs of a singular and a plural form.
+var irregularNouns = [][2]string{
+	{"alias", "aliases"}, {"analysis", "analyses"}, {"atlas", "atlases"}, {"axis", "axes"}, {"basis", "bases"},
+	{"bonus", "bonuses"}, {"bus", "buses"}, {"calf", "calves"}, {"campus", "campuses"}, {"canvas", "canvases"},
+	{"census", "censuses"}, {"child", "children"}, {"cookie", "cookies"}, {"crisis", "crises"}, {"foot", "feet"},
+	{"gas", "gases"}, {"goose", "geese"}, {"half", "halves"}, {"index", "indices"}, {"knife", "knives"},
+	{"leaf", "leaves"}, {"lens", "lenses"}, {"life", "lives"}, {"man", "men"}, {"matrix", "matrices"},
+	{"mouse", "mice"}, {"movie", "movies"}, {"ox", "oxen"}, {"person", "people"}, {"quiz", "quizzes"},
+	{"shelf", "shelves"}, {"status", "statuses"}, {"thesis", "theses"}, {"tooth", "teeth"}, {"vertex", "vertices"},
+	{"virus", "viruses"}, {"wife", "wives"}, {"wolf", "wolves"}, {"woman", "women"},
+}
+
+// WithInflection returns an Option that replaces the final word in the result of Convert by the result of inflect,
+// such as its plural form (see PluralizeWord), so an inflector can be plugged into the conversion.
+// The final word is inflected before it's formatted, so "UserAddress" becomes "user_addresses" using Snake and
+// WithInflection(PluralizeWord).
+// NOTE: When Convert reproduces github.com/iancoleman/strcase (see WithStrcaseCompat), the final word isn't inflected.
+func WithInflection(inflect func(word string) string) Option {
+	return func(s *Splitter) {
+		s.inflect = inflect
+	}
+}
+
+// Pluralize converts v into a string using the naming convention to, after replacing its final word by its English
+// plural form (see PluralizeWord), so the type "UserAddress" becomes the table "user_addresses" using Snake.
+// If v isn't a valid UTF-8 string, v is returned unmodified.
+func Pluralize(v string, to Style) string {
+	return New(WithInflection(PluralizeWord)).Convert(v, to)
+}
+
+// Singularize converts v into a string using the naming convention to, after replacing its final word by its English
+// singular form (see SingularizeWord), so the table "user_addresses" becomes the type "UserAddress" using Pascal.
+// If v isn't a valid UTF-8 string, v is returned unmodified.
+func Singularize(v string, to Style) string {
+	return New(WithInflection(SingularizeWord)).Convert(v, to)
+}
+
+// PluralizeWord returns the English plural form of the singular noun w, such as "addresses" for "address" or "people"
+// for "person".
+// Nouns that end with "s" (and that aren't irregular) are treated as plural nouns already, and are returned unmodified.
+// A word that contains at least 2 letters and no lowercase letters is treated as an acronym, which is pluralized by
+// appending a lowercase "s", so the plural form of "API" is "APIs".
+func PluralizeWord(w string) string {
+	lw := strings.ToLower(w)
+
+	if isListed(lw, uncountableNouns) {
+		return w
+	}
+
+	for _, pair := range irregularNouns {
+		switch lw {
+		case pair[0]:
+			return matchCase(pair[1], w)
+		case pair[1]:
+			return w
+		}
+	}
+
+	switch {
+	case isAcronym(w):
+		return w + "s"
+	case strings.HasSuffix(lw, "sis"):
+		return w[:len(w)-2] + "es"
+	case hasAnySuffix(lw, "ss", "sh", "ch", "x", "z"):
+		return w + "es"
+	case strings.HasSuffix(lw, "s"):
+		return w
+	case strings.HasSuffix(lw, "y") && !hasAnySuffix(lw, "ay", "ey", "iy", "oy", "uy"):
+		return w[:len(w)-1] + "ies"
+	}
+
+	return w + "s"
+}
+
+// SingularizeWord returns the English singular form of the plural noun w, such as "address" for "addresses" or
+// "person" for "people".
+// Nouns that don't end with "s" (and that aren't irregular) are treated as singular nouns already, and are returned
+// unmodified. An acronym followed by a lowercase "s" (such as "APIs") is singularized by removing the "s".
+func SingularizeWord(w string) string {
+	lw := strings.ToLower(w)
+
+	if isListed(lw, uncountableNouns) {
+		return w
+	}
+
+	for _, pair := range irregularNouns {
+		switch lw {
+		case pair[1]:
+			return matchCase(pair[0], w)
+		case pair[0]:
+			return w
+		}
+	}
+
+	switch {
+	case len(w) > 2 && w[len(w)-1] == 's' && isAcronym(w[:len(w)-1]):
+		return w[:len(w)-1]
+	case hasAnySuffix(lw, "sses", "shes", "xes", "zzes", "tzes"):
+		return w[:len(w)-2]
+	case strings.HasSuffix(lw, "ches") && endsWithSingleVowel(lw[:len(lw)-4]):
+		// NOTE: The singular form of "caches" and "niches" ends with an "e", unlike the one of "matches" or "beaches".
+		return w[:len(w)-1]
+	case strings.HasSuffix(lw, "ches"):
+		return w[:len(w)-2]
+	case strings.HasSuffix(lw, "ies") && len(lw) > 3:
+		return w[:len(w)-3] + "y"
+	case strings.HasSuffix(lw, "s") && !hasAnySuffix(lw, "ss", "us", "is"):
+		return w[:len(w)-1]
+	}
+
+	return w
+}
+
+// Checks whether or not v ends with any of the suffixes.
+func hasAnySuffix(v string, suffixes ...string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(v, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Checks whether or not v ends with a single vowel (a vowel that doesn't follow another vowel), such as "ca" in
+// "caches".
+func endsWithSingleVowel(v string) bool {
+	isVowel := func(b byte) bool { return strings.IndexByte("aeiou", b) >= 0 }
+
+	return len(v) > 0 && isVowel(v[len(v)-1]) && (len(v) == 1 || !isVowel(v[len(v)-2]))
+}
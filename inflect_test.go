@@ -0,0 +1,202 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Convert a string into its plural form.
+func TestPluralize(t *testing.T) {
+	for _, tc := range []struct {
+		vInput string
+		vStyle camelcase.Style
+		want   string
+	}{
+		{vInput: "", vStyle: camelcase.Snake, want: ""},
+		{vInput: "User", vStyle: camelcase.Snake, want: "users"},
+		{vInput: "UserAddress", vStyle: camelcase.Snake, want: "user_addresses"},
+		{vInput: "OrderCategory", vStyle: camelcase.Kebab, want: "order-categories"},
+		{vInput: "SalesPerson", vStyle: camelcase.Snake, want: "sales_people"},
+		{vInput: "user_status", vStyle: camelcase.Pascal, want: "UserStatuses"},
+		{vInput: "ORDER_ITEM", vStyle: camelcase.ScreamingSnake, want: "ORDER_ITEMS"},
+		{vInput: "ListAPI", vStyle: camelcase.Pascal, want: "ListAPIs"},
+		{vInput: "userMetadata", vStyle: camelcase.Snake, want: "user_metadata"},
+		{vInput: "users", vStyle: camelcase.Snake, want: "users"},
+		{vInput: "user\xffaddress", vStyle: camelcase.Snake, want: "user\xffaddress"},
+	} {
+		// ACT.
+		got := camelcase.Pluralize(tc.vInput, tc.vStyle)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Convert a string into its plural form.\n"+
+			"Input:    %v (%v)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vStyle, tc.want, got)
+	}
+}
+
+// UT: Convert a string into its singular form.
+func TestSingularize(t *testing.T) {
+	for _, tc := range []struct {
+		vInput string
+		vStyle camelcase.Style
+		want   string
+	}{
+		{vInput: "", vStyle: camelcase.Pascal, want: ""},
+		{vInput: "users", vStyle: camelcase.Pascal, want: "User"},
+		{vInput: "user_addresses", vStyle: camelcase.Pascal, want: "UserAddress"},
+		{vInput: "order-categories", vStyle: camelcase.Pascal, want: "OrderCategory"},
+		{vInput: "sales_people", vStyle: camelcase.Camel, want: "salesPerson"},
+		{vInput: "user_statuses", vStyle: camelcase.Pascal, want: "UserStatus"},
+		{vInput: "USER_STATUS", vStyle: camelcase.Snake, want: "user_status"},
+		{vInput: "tv_series", vStyle: camelcase.Pascal, want: "TvSeries"},
+		{vInput: "user_caches", vStyle: camelcase.Pascal, want: "UserCache"},
+		{vInput: "page_niches", vStyle: camelcase.Pascal, want: "PageNiche"},
+		{vInput: "drawing_canvas", vStyle: camelcase.Pascal, want: "DrawingCanvas"},
+	} {
+		// ACT.
+		got := camelcase.Singularize(tc.vInput, tc.vStyle)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Convert a string into its singular form.\n"+
+			"Input:    %v (%v)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vStyle, tc.want, got)
+	}
+}
+
+// UT: Convert a singular noun into its plural form, and back.
+func TestPluralizeWord(t *testing.T) {
+	for _, tc := range []struct {
+		vInput string
+		want   string
+	}{
+		{vInput: "user", want: "users"},
+		{vInput: "address", want: "addresses"},
+		{vInput: "box", want: "boxes"},
+		{vInput: "match", want: "matches"},
+		{vInput: "dish", want: "dishes"},
+		{vInput: "category", want: "categories"},
+		{vInput: "key", want: "keys"},
+		{vInput: "analysis", want: "analyses"},
+		{vInput: "Person", want: "People"},
+		{vInput: "CHILD", want: "CHILDREN"},
+		{vInput: "Matrix", want: "Matrices"},
+		{vInput: "movie", want: "movies"},
+		{vInput: "sheep", want: "sheep"},
+		{vInput: "Information", want: "Information"},
+		{vInput: "cache", want: "caches"},
+		{vInput: "niche", want: "niches"},
+		{vInput: "mustache", want: "mustaches"},
+		{vInput: "beach", want: "beaches"},
+		{vInput: "church", want: "churches"},
+		{vInput: "case", want: "cases"},
+		{vInput: "house", want: "houses"},
+		{vInput: "database", want: "databases"},
+		{vInput: "size", want: "sizes"},
+		{vInput: "buzz", want: "buzzes"},
+		{vInput: "waltz", want: "waltzes"},
+		{vInput: "quiz", want: "quizzes"},
+		{vInput: "canvas", want: "canvases"},
+		{vInput: "gas", want: "gases"},
+		{vInput: "lens", want: "lenses"},
+		{vInput: "Status", want: "Statuses"},
+		{vInput: "API", want: "APIs"},
+		{vInput: "URL", want: "URLs"},
+	} {
+		// ACT.
+		got := camelcase.PluralizeWord(tc.vInput)
+		back := camelcase.SingularizeWord(got)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Convert a singular noun into its plural form.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+
+		assert.Equal(t, back, tc.vInput, "", "\n\n"+
+			"UT Name:  Convert a plural noun into its singular form.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", got, tc.vInput, back)
+	}
+}
+
+// UT: Inflecting a noun that's already in the requested form doesn't modify it.
+func TestInflectWordUnmodified(t *testing.T) {
+	for _, tc := range []struct {
+		vInput string
+		vFn    func(w string) string
+	}{
+		{vInput: "users", vFn: camelcase.PluralizeWord},
+		{vInput: "people", vFn: camelcase.PluralizeWord},
+		{vInput: "quizzes", vFn: camelcase.PluralizeWord},
+		{vInput: "user", vFn: camelcase.SingularizeWord},
+		{vInput: "canvas", vFn: camelcase.SingularizeWord},
+		{vInput: "gas", vFn: camelcase.SingularizeWord},
+		{vInput: "lens", vFn: camelcase.SingularizeWord},
+		{vInput: "status", vFn: camelcase.SingularizeWord},
+		{vInput: "analysis", vFn: camelcase.SingularizeWord},
+		{vInput: "person", vFn: camelcase.SingularizeWord},
+	} {
+		// ACT.
+		got := tc.vFn(tc.vInput)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.vInput, "", "\n\n"+
+			"UT Name:  Inflecting a noun that's already in the requested form doesn't modify it.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vInput, got)
+	}
+}
+
+// UT: Convert a string, inflecting its final word using a custom inflector.
+func TestWithInflection(t *testing.T) {
+	// ARRANGE.
+	s := camelcase.New(camelcase.WithAcronyms("ID"), camelcase.WithInflection(func(w string) string {
+		return strings.ToUpper(w)
+	}))
+
+	// ACT.
+	got := s.Convert("order_item_id", camelcase.Pascal)
+
+	// ASSERT.
+	assert.Equal(t, got, "OrderItemID", "", "\n\n"+
+		"UT Name:  Convert a string, inflecting its final word using a custom inflector.\n"+
+		"Input:    %v\n"+
+		"\033[32mExpected: %v\033[0m\n"+
+		"\033[31mActual:   %v\033[0m\n\n", "order_item_id", "OrderItemID", got)
+}
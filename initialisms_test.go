@@ -0,0 +1,77 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Convert a string using the common initialisms used in Go.
+func TestWithGoInitialisms(t *testing.T) {
+	for _, tc := range []struct {
+		vInput string
+		vStyle camelcase.Style
+		want   string
+	}{
+		{vInput: "userId", vStyle: camelcase.Pascal, want: "UserID"},
+		{vInput: "UserID", vStyle: camelcase.Pascal, want: "UserID"},
+		{vInput: "userId", vStyle: camelcase.Camel, want: "userID"},
+		{vInput: "ApiUrl", vStyle: camelcase.Pascal, want: "APIURL"},
+		{vInput: "api_url", vStyle: camelcase.Camel, want: "apiURL"},
+		{vInput: "HttpsServer", vStyle: camelcase.Pascal, want: "HTTPSServer"},
+		{vInput: "JSONPayload", vStyle: camelcase.Snake, want: "json_payload"},
+		{vInput: "Identity", vStyle: camelcase.Pascal, want: "Identity"},
+	} {
+		// ACT.
+		got := camelcase.New(camelcase.WithGoInitialisms()).Convert(tc.vInput, tc.vStyle)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Convert a string using the common initialisms used in Go.\n"+
+			"Input:    %v (%v)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vStyle, tc.want, got)
+	}
+}
+
+// UT: Modifying the slice returned by GoInitialisms doesn't modify the common initialisms used in Go.
+func TestGoInitialismsIsCopy(t *testing.T) {
+	// ARRANGE.
+	camelcase.GoInitialisms()[0] = "Changed"
+
+	// ACT.
+	got := camelcase.GoInitialisms()[0]
+
+	// ASSERT.
+	assert.Equal(t, got, "ACL", "", "\n\n"+
+		"UT Name:  Modifying the slice returned by GoInitialisms doesn't modify the common initialisms used in Go.\n"+
+		"\033[32mExpected: %v\033[0m\n"+
+		"\033[31mActual:   %v\033[0m\n\n", "ACL", got)
+}
@@ -0,0 +1,145 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Join concatenates words into a single "CamelCase" string where each word starts with an uppercase (or titlecase)
+// rune.
+// The remaining runes of each word are kept as-is, so acronyms such as "HTML" are preserved.
+func Join(words []string) string {
+	return caseMapping{}.join(words, "", upperFirstCase, upperFirstCase)
+}
+
+// JoinLower concatenates words into a single "camelCase" string.
+// The first word is converted to lowercase, each other word starts with an uppercase rune.
+func JoinLower(words []string) string {
+	return caseMapping{}.join(words, "", lowerCase, upperFirstCase)
+}
+
+// Defines how the runes of a word are converted when joining words.
+type wordCase int
+
+// The supported conversions.
+const (
+	keepCase       wordCase = iota // The word is kept as-is.
+	lowerCase                      // The word is converted to lowercase.
+	upperCase                      // The word is converted to uppercase.
+	upperFirstCase                 // The first rune of the word is converted to titlecase.
+)
+
+// Defines how runes are mapped to lowercase and uppercase.
+type caseMapping struct {
+	special unicode.SpecialCase // The language specific case mappings, or nil to use the Unicode case mappings.
+}
+
+// Join words into a single string using sep.
+// The first word is converted using first, each other word is converted using rest.
+func (c caseMapping) join(words []string, sep string, first, rest wordCase) string {
+	var sb strings.Builder
+
+	sb.Grow(wordsLen(words) + len(sep)*len(words))
+
+	for idx, w := range words {
+		wc := rest
+
+		if idx == 0 {
+			wc = first
+		} else {
+			sb.WriteString(sep)
+		}
+
+		c.write(&sb, w, wc)
+	}
+
+	return sb.String()
+}
+
+// Write w to sb, after converting it using wc.
+func (c caseMapping) write(sb *strings.Builder, w string, wc wordCase) {
+	switch wc {
+	case lowerCase:
+		sb.WriteString(c.toLower(w))
+	case upperCase:
+		sb.WriteString(c.toUpper(w))
+	case upperFirstCase:
+		// NOTE: Leading underscores (see WithUnderscoreAffixes) are kept, so the first rune after them is converted.
+		rest := strings.TrimLeft(w, "_")
+		r, size := utf8.DecodeRuneInString(rest)
+
+		sb.WriteString(w[:len(w)-len(rest)])
+
+		if size > 0 {
+			sb.WriteRune(c.toTitleRune(r))
+			sb.WriteString(rest[size:])
+		}
+	default:
+		sb.WriteString(w)
+	}
+}
+
+// Returns v with all runes converted to lowercase.
+func (c caseMapping) toLower(v string) string {
+	if c.special == nil {
+		return strings.ToLower(v)
+	}
+
+	return strings.ToLowerSpecial(c.special, v)
+}
+
+// Returns v with all runes converted to uppercase.
+func (c caseMapping) toUpper(v string) string {
+	if c.special == nil {
+		return strings.ToUpper(v)
+	}
+
+	return strings.ToUpperSpecial(c.special, v)
+}
+
+// Returns r converted to titlecase.
+// For most runes, the titlecase is equal to the uppercase, but digraphs such as "ǆ" are mapped to "ǅ" instead of "Ǆ".
+func (c caseMapping) toTitleRune(r rune) rune {
+	if c.special == nil {
+		return unicode.ToTitle(r)
+	}
+
+	return c.special.ToTitle(r)
+}
+
+// Returns the total length (in bytes) of words.
+func wordsLen(words []string) int {
+	n := 0
+
+	for _, w := range words {
+		n = n + len(w)
+	}
+
+	return n
+}
@@ -0,0 +1,131 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Join a slice of words into a "CamelCase" string.
+func TestJoin(t *testing.T) {
+	for _, tc := range []struct {
+		vInput []string
+		want   string
+	}{
+		{
+			vInput: nil,
+			want:   "",
+		},
+		{
+			vInput: []string{"lowercase"},
+			want:   "Lowercase",
+		},
+		{
+			vInput: []string{"Multiple", "Words"},
+			want:   "MultipleWords",
+		},
+		{
+			vInput: []string{"PDF", "Loader"},
+			want:   "PDFLoader",
+		},
+		{
+			vInput: []string{"GL", "11", "Version"},
+			want:   "GL11Version",
+		},
+		{
+			vInput: []string{"parse", "", "html"},
+			want:   "ParseHtml",
+		},
+	} {
+		// ACT.
+		got := camelcase.Join(tc.vInput)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Join a slice of words into a \"CamelCase\" string.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Join a slice of words into a "camelCase" string.
+func TestJoinLower(t *testing.T) {
+	for _, tc := range []struct {
+		vInput []string
+		want   string
+	}{
+		{
+			vInput: nil,
+			want:   "",
+		},
+		{
+			vInput: []string{"Lowercase"},
+			want:   "lowercase",
+		},
+		{
+			vInput: []string{"Multiple", "Words"},
+			want:   "multipleWords",
+		},
+		{
+			vInput: []string{"HTML", "Parser"},
+			want:   "htmlParser",
+		},
+		{
+			vInput: []string{"parse", "HTML"},
+			want:   "parseHTML",
+		},
+	} {
+		// ACT.
+		got := camelcase.JoinLower(tc.vInput)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Join a slice of words into a \"camelCase\" string.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Join the words returned by Split to get the original "CamelCase" string.
+func TestJoinSplit(t *testing.T) {
+	for _, tc := range []string{"Uppercase", "MultipleWords", "HTML", "PDFLoader", "GL11Version", "5May2000"} {
+		// ACT.
+		got := camelcase.Join(camelcase.Split(tc))
+
+		// ASSERT.
+		assert.Equal(t, got, tc, "", "\n\n"+
+			"UT Name:  Join the words returned by Split.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc, tc, got)
+	}
+}
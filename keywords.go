@@ -0,0 +1,111 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import (
+	"unicode"
+
+	"github.com/kdeconinck/slices"
+)
+
+// The keywords of Go, as defined by the Go specification.
+var goKeywords = []string{
+	"break", "case", "chan", "const", "continue", "default", "defer", "else", "fallthrough", "for", "func", "go",
+	"goto", "if", "import", "interface", "map", "package", "range", "return", "select", "struct", "switch", "type",
+	"var",
+}
+
+// The keywords and the predeclared identifiers of Go, as defined by the Go specification.
+var goReservedNames = append(append([]string(nil), goKeywords...),
+	"any", "bool", "byte", "comparable", "complex64", "complex128", "error", "float32", "float64", "int", "int8",
+	"int16", "int32", "int64", "rune", "string", "uint", "uint8", "uint16", "uint32", "uint64", "uintptr", "true",
+	"false", "iota", "nil", "append", "cap", "clear", "close", "complex", "copy", "delete", "imag", "len", "make",
+	"max", "min", "new", "panic", "print", "println", "real", "recover",
+)
+
+// GoReservedNames returns the keywords (such as "type") and the predeclared identifiers (such as "len") of Go.
+// The returned slice is a copy and can be modified safely.
+func GoReservedNames() []string {
+	return append([]string(nil), goReservedNames...)
+}
+
+// WithGoKeywordEscape returns an Option that replaces the result of Convert by the result of escape when it's a Go
+// keyword or a predeclared identifier (see GoReservedNames), so generated identifiers such as "type" or "len" remain
+// valid (and don't shadow builtins).
+// If escape is nil, an underscore is appended, so "type" becomes "type_". Other escapes, such as prepending a prefix,
+// can be configured: WithGoKeywordEscape(func(v string) string { return "x" + v }).
+func WithGoKeywordEscape(escape func(v string) string) Option {
+	if escape == nil {
+		escape = func(v string) string { return v + "_" }
+	}
+
+	return func(s *Splitter) {
+		s.escape = escape
+	}
+}
+
+// Returns v, escaped using the escape of s when it's a Go keyword or a predeclared identifier.
+func (s *Splitter) escapeReserved(v string) string {
+	if s.escape == nil || !slices.Contains(goReservedNames, v) {
+		return v
+	}
+
+	return s.escape(v)
+}
+
+// IsValidGoIdentifier checks whether or not v is a valid Go identifier: a letter (or an underscore) followed by
+// letters, underscores and digits, that isn't a keyword (such as "type").
+// NOTE: The predeclared identifiers (such as "len" or "string") are valid identifiers, since they can be redeclared.
+func IsValidGoIdentifier(v string) bool {
+	if v == "" || slices.Contains(goKeywords, v) {
+		return false
+	}
+
+	for idx, r := range v {
+		if !isGoIdentifierRune(r, idx == 0) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsExportedGoIdentifier checks whether or not v is a valid Go identifier (see IsValidGoIdentifier) that's exported,
+// meaning it starts with an uppercase letter (such as "UserID").
+func IsExportedGoIdentifier(v string) bool {
+	return IsValidGoIdentifier(v) && unicode.IsUpper(firstRune(v))
+}
+
+// IsUnexportedGoIdentifier checks whether or not v is a valid Go identifier (see IsValidGoIdentifier) that isn't
+// exported, meaning it doesn't start with an uppercase letter (such as "userID" or "_userID").
+func IsUnexportedGoIdentifier(v string) bool {
+	return IsValidGoIdentifier(v) && !unicode.IsUpper(firstRune(v))
+}
+
+// Checks whether or not r can be used in a Go identifier. When first is true, r must be a letter or an underscore.
+func isGoIdentifierRune(r rune, first bool) bool {
+	return r == '_' || unicode.IsLetter(r) || (!first && isDigit(r))
+}
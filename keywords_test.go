@@ -0,0 +1,139 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Convert a string, escaping Go keywords and predeclared identifiers.
+func TestWithGoKeywordEscape(t *testing.T) {
+	// ARRANGE.
+	escape := []camelcase.Option{camelcase.WithGoKeywordEscape(nil)}
+
+	for _, tc := range []struct {
+		vInput string
+		vStyle camelcase.Style
+		vOpts  []camelcase.Option
+		want   string
+	}{
+		{vInput: "Type", vStyle: camelcase.Camel, want: "type"},
+		{vInput: "Type", vStyle: camelcase.Camel, vOpts: escape, want: "type_"},
+		{vInput: "LEN", vStyle: camelcase.Snake, vOpts: escape, want: "len_"},
+		{vInput: "type", vStyle: camelcase.Pascal, vOpts: escape, want: "Type"},
+		{vInput: "typeName", vStyle: camelcase.Camel, vOpts: escape, want: "typeName"},
+		{
+			vInput: "func",
+			vStyle: camelcase.Camel,
+			vOpts: []camelcase.Option{
+				camelcase.WithGoKeywordEscape(func(v string) string { return "x" + v }),
+			},
+			want: "xfunc",
+		},
+		{
+			vInput: "TYPE",
+			vStyle: camelcase.Camel,
+			vOpts: []camelcase.Option{
+				camelcase.WithStrcaseCompat(),
+				camelcase.WithGoKeywordEscape(nil),
+			},
+			want: "type_",
+		},
+	} {
+		// ACT.
+		got := camelcase.New(tc.vOpts...).Convert(tc.vInput, tc.vStyle)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Convert a string, escaping Go keywords and predeclared identifiers.\n"+
+			"Input:    %v (%v)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vStyle, tc.want, got)
+	}
+}
+
+// UT: Modifying the slice returned by GoReservedNames doesn't modify the reserved names of Go.
+func TestGoReservedNamesIsCopy(t *testing.T) {
+	// ARRANGE.
+	camelcase.GoReservedNames()[0] = "Changed"
+
+	// ACT.
+	got := camelcase.GoReservedNames()[0]
+
+	// ASSERT.
+	assert.Equal(t, got, "break", "", "\n\n"+
+		"UT Name:  Modifying the slice returned by GoReservedNames doesn't modify the reserved names of Go.\n"+
+		"\033[32mExpected: %v\033[0m\n"+
+		"\033[31mActual:   %v\033[0m\n\n", "break", got)
+}
+
+// UT: Check whether or not a string is a valid Go identifier.
+func TestIsValidGoIdentifier(t *testing.T) {
+	for _, tc := range []struct {
+		vInput         string
+		want           bool
+		wantExported   bool
+		wantUnexported bool
+	}{
+		{vInput: "", want: false, wantExported: false, wantUnexported: false},
+		{vInput: "userID", want: true, wantExported: false, wantUnexported: true},
+		{vInput: "UserID", want: true, wantExported: true, wantUnexported: false},
+		{vInput: "_userID", want: true, wantExported: false, wantUnexported: true},
+		{vInput: "_", want: true, wantExported: false, wantUnexported: true},
+		{vInput: "user_id2", want: true, wantExported: false, wantUnexported: true},
+		{vInput: "2fa", want: false, wantExported: false, wantUnexported: false},
+		{vInput: "user-id", want: false, wantExported: false, wantUnexported: false},
+		{vInput: "user id", want: false, wantExported: false, wantUnexported: false},
+		{vInput: "type", want: false, wantExported: false, wantUnexported: false},
+		{vInput: "Type", want: true, wantExported: true, wantUnexported: false},
+		{vInput: "len", want: true, wantExported: false, wantUnexported: true},
+		{vInput: "Größe", want: true, wantExported: true, wantUnexported: false},
+		{vInput: "Δx", want: true, wantExported: true, wantUnexported: false},
+		{vInput: "x٣", want: true, wantExported: false, wantUnexported: true},
+		{vInput: "e\u0301", want: false, wantExported: false, wantUnexported: false},
+		{vInput: "user\xffid", want: false, wantExported: false, wantUnexported: false},
+	} {
+		// ACT.
+		got := []bool{
+			camelcase.IsValidGoIdentifier(tc.vInput),
+			camelcase.IsExportedGoIdentifier(tc.vInput),
+			camelcase.IsUnexportedGoIdentifier(tc.vInput),
+		}
+
+		// ASSERT.
+		want := []bool{tc.want, tc.wantExported, tc.wantUnexported}
+
+		assert.EqualS(t, got, want, "", "\n\n"+
+			"UT Name:  Check whether or not a string is a valid Go identifier.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, want, got)
+	}
+}
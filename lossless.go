@@ -0,0 +1,149 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Metadata holds the information that's needed to restore a string after converting it into another naming
+// convention (see ConvertLossless and Restore), such as the original casing of its acronyms, the positions of its
+// delimiters and the grouping of its digits.
+// The fields are exported, so the metadata can be stored (e.g. as JSON) together with the converted string.
+type Metadata struct {
+	Words      []string // The words of the original string, as written.
+	Delimiters []string // The delimiters before each word and after the last word (one more than the words).
+}
+
+// RestoreError is returned when a string can't be restored, since it isn't a conversion of the original string.
+type RestoreError struct {
+	Input    string // The string that was restored.
+	Original string // The original string, as recorded in the metadata.
+}
+
+// Error returns a description of e.
+func (e *RestoreError) Error() string {
+	return "camelcase: " + strconv.Quote(e.Input) + " isn't a conversion of " + strconv.Quote(e.Original)
+}
+
+// ConvertLossless converts v into a string using the naming convention to (see Convert), and returns the metadata that
+// restores v from the converted string (see Restore).
+func ConvertLossless(v string, to Style) (string, Metadata) {
+	m := Metadata{Words: make([]string, 0), Delimiters: make([]string, 0)}
+
+	if !utf8.ValidString(v) {
+		m.Words, m.Delimiters = append(m.Words, v), append(m.Delimiters, "", "")
+
+		return v, m
+	}
+
+	dIdx := 0 // The position (in v) where the current sequence of delimiters starts.
+
+	for idx := 0; idx < len(v); {
+		sIdx := idx
+
+		for idx < len(v) && !startsWithDelimiter(v[idx:]) {
+			_, size := utf8.DecodeRuneInString(v[idx:])
+			idx = idx + size
+		}
+
+		if idx > sIdx {
+			for wIdx, w := range Split(v[sIdx:idx]) {
+				if wIdx == 0 {
+					m.Delimiters = append(m.Delimiters, v[dIdx:sIdx])
+				} else {
+					m.Delimiters = append(m.Delimiters, "")
+				}
+
+				m.Words = append(m.Words, w)
+			}
+
+			dIdx = idx
+		}
+
+		for idx < len(v) && startsWithDelimiter(v[idx:]) {
+			_, size := utf8.DecodeRuneInString(v[idx:])
+			idx = idx + size
+		}
+	}
+
+	m.Delimiters = append(m.Delimiters, v[dIdx:])
+
+	return Convert(v, to), m
+}
+
+// Restore returns the original string of the conversion v, as recorded in m (see ConvertLossless).
+// The original string is restored as long as v only differs from it in the case of its runes and in its delimiters, so
+// v can be converted into another naming convention first.
+// If v isn't a conversion of the original string, a *RestoreError is returned.
+func Restore(v string, m Metadata) (string, error) {
+	original := m.original()
+
+	if v != original && !strings.EqualFold(withoutDelimiters(v), strings.Join(m.Words, "")) {
+		return "", &RestoreError{Input: v, Original: original}
+	}
+
+	return original, nil
+}
+
+// Returns the original string, by joining the words and the delimiters of m.
+func (m Metadata) original() string {
+	var sb strings.Builder
+
+	for idx, w := range m.Words {
+		if idx < len(m.Delimiters) {
+			sb.WriteString(m.Delimiters[idx])
+		}
+
+		sb.WriteString(w)
+	}
+
+	if len(m.Delimiters) > len(m.Words) {
+		sb.WriteString(m.Delimiters[len(m.Words)])
+	}
+
+	return sb.String()
+}
+
+// Checks whether or not v starts with a delimiter.
+func startsWithDelimiter(v string) bool {
+	r, _ := utf8.DecodeRuneInString(v)
+
+	return isDelimiter(r)
+}
+
+// Returns v without its delimiters.
+func withoutDelimiters(v string) string {
+	return strings.Map(func(r rune) rune {
+		if isDelimiter(r) {
+			return -1
+		}
+
+		return r
+	}, v)
+}
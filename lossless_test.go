@@ -0,0 +1,146 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Convert a string into a specific naming convention and restore it.
+func TestConvertLossless(t *testing.T) {
+	for _, tc := range []struct {
+		vInput         string
+		vStyle         camelcase.Style
+		wantConverted  string
+		wantWords      []string
+		wantDelimiters []string
+	}{
+		{
+			vInput:         "",
+			vStyle:         camelcase.Snake,
+			wantConverted:  "",
+			wantWords:      []string{},
+			wantDelimiters: []string{""},
+		},
+		{
+			vInput:         "parseHTTPHeader2",
+			vStyle:         camelcase.Snake,
+			wantConverted:  "parse_http_header_2",
+			wantWords:      []string{"parse", "HTTP", "Header", "2"},
+			wantDelimiters: []string{"", "", "", "", ""},
+		},
+		{
+			vInput:         "__user--ID ",
+			vStyle:         camelcase.Pascal,
+			wantConverted:  "UserID",
+			wantWords:      []string{"user", "ID"},
+			wantDelimiters: []string{"__", "--", " "},
+		},
+		{
+			vInput:         "BadUTF8\xe2\xe2\xa1",
+			vStyle:         camelcase.Snake,
+			wantConverted:  "BadUTF8\xe2\xe2\xa1",
+			wantWords:      []string{"BadUTF8\xe2\xe2\xa1"},
+			wantDelimiters: []string{"", ""},
+		},
+	} {
+		// ACT.
+		gotConverted, gotMetadata := camelcase.ConvertLossless(tc.vInput, tc.vStyle)
+		gotRestored, err := camelcase.Restore(gotConverted, gotMetadata)
+
+		// ASSERT.
+		assert.Equal(t, gotConverted, tc.wantConverted, "", "\n\n"+
+			"UT Name:  Convert a string into a specific naming convention and restore it.\n"+
+			"Input:    %v (%v)\n"+
+			"\033[32mExpected (converted): %v\033[0m\n"+
+			"\033[31mActual (converted):   %v\033[0m\n\n", tc.vInput, tc.vStyle, tc.wantConverted, gotConverted)
+
+		assert.EqualS(t, gotMetadata.Words, tc.wantWords, "", "\n\n"+
+			"UT Name:  Convert a string into a specific naming convention and restore it.\n"+
+			"Input:    %v (%v)\n"+
+			"\033[32mExpected (words): %v\033[0m\n"+
+			"\033[31mActual (words):   %v\033[0m\n\n", tc.vInput, tc.vStyle, tc.wantWords, gotMetadata.Words)
+
+		assert.EqualS(t, gotMetadata.Delimiters, tc.wantDelimiters, "", "\n\n"+
+			"UT Name:  Convert a string into a specific naming convention and restore it.\n"+
+			"Input:    %v (%v)\n"+
+			"\033[32mExpected (delimiters): %q\033[0m\n"+
+			"\033[31mActual (delimiters):   %q\033[0m\n\n",
+			tc.vInput, tc.vStyle, tc.wantDelimiters, gotMetadata.Delimiters)
+
+		assert.Equal(t, gotRestored, tc.vInput, "", "\n\n"+
+			"UT Name:  Convert a string into a specific naming convention and restore it.\n"+
+			"Input:    %v (%v)\n"+
+			"\033[32mExpected (restored): %v (%v)\033[0m\n"+
+			"\033[31mActual (restored):   %v (%v)\033[0m\n\n", tc.vInput, tc.vStyle, tc.vInput, nil, gotRestored, err)
+	}
+}
+
+// UT: Restore a string that was converted into multiple naming conventions.
+func TestRestore(t *testing.T) {
+	// ARRANGE.
+	_, metadata := camelcase.ConvertLossless("getHTTPServer_v2", camelcase.Snake)
+
+	for _, tc := range []struct {
+		vInput  string
+		want    string
+		wantErr string
+	}{
+		{vInput: "get_http_server_v_2", want: "getHTTPServer_v2"},
+		{vInput: "get-http-server-v-2", want: "getHTTPServer_v2"},
+		{vInput: "GetHttpServerV2", want: "getHTTPServer_v2"},
+		{
+			vInput:  "get_http_client_v_2",
+			wantErr: `camelcase: "get_http_client_v_2" isn't a conversion of "getHTTPServer_v2"`,
+		},
+	} {
+		// ACT.
+		got, err := camelcase.Restore(tc.vInput, metadata)
+
+		gotErr := ""
+
+		if err != nil {
+			gotErr = err.Error()
+		}
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Restore a string that was converted into multiple naming conventions.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+
+		assert.Equal(t, gotErr, tc.wantErr, "", "\n\n"+
+			"UT Name:  Restore a string that was converted into multiple naming conventions.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected (error): %v\033[0m\n"+
+			"\033[31mActual (error):   %v\033[0m\n\n", tc.vInput, tc.wantErr, gotErr)
+	}
+}
@@ -0,0 +1,399 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// ContainsWord reports whether or not word is one of the words of v, splitting both on delimiters and on "CamelCase"
+// boundaries.
+// If word consists of multiple words (such as "UserID"), these words must be found consecutively in v, so
+// ContainsWord("ParseHTMLDocument", "HTML") and ContainsWord("parse_HTML_document", "HTML") return true, but
+// ContainsWord("ShtmlFile", "HTML") returns false.
+func ContainsWord(v, word string) bool {
+	return indexWords(splitWords(v, nil), splitWords(word, nil), equal) >= 0
+}
+
+// ContainsWordFold reports whether or not word is one of the words of v (see ContainsWord), ignoring case.
+// If word consists of multiple words (such as "UserID"), these words must be found consecutively in v.
+func ContainsWordFold(v, word string) bool {
+	return indexWords(splitWords(v, nil), splitWords(word, nil), strings.EqualFold) >= 0
+}
+
+// HasPrefixWords reports whether or not v starts with words, comparing whole words (splitting on delimiters and on
+// "CamelCase" boundaries).
+// In contrast to strings.HasPrefix, HasPrefixWords("Getaway", "Get") returns false, since "Getaway" is a single word.
+// Each element of words might consist of multiple words, so HasPrefixWords("GetUserByID", "GetUser") and
+// HasPrefixWords("get_user_by_id", "get_user") return true.
+func HasPrefixWords(v string, words ...string) bool {
+	return hasWordsAt(splitWords(v, nil), splitAll(words), 0, equal)
+}
+
+// HasSuffixWords reports whether or not v ends with words, comparing whole words (splitting on delimiters and on
+// "CamelCase" boundaries).
+// In contrast to strings.HasSuffix, HasSuffixWords("Prevent", "Event") returns false, since "Prevent" is a single word.
+// Each element of words might consist of multiple words, so HasSuffixWords("UserCreatedEvent", "CreatedEvent") and
+// HasSuffixWords("user-created-event", "created-event") return true.
+func HasSuffixWords(v string, words ...string) bool {
+	vWords, sub := splitWords(v, nil), splitAll(words)
+
+	return hasWordsAt(vWords, sub, len(vWords)-len(sub), equal)
+}
+
+// TrimPrefixWord returns v without the leading word w, comparing whole words (splitting on delimiters and on
+// "CamelCase" boundaries).
+// If v doesn't start with w, v is returned unmodified. The remainder of v isn't modified, so
+// TrimPrefixWord("GetHTTPClient", "Get") returns "HTTPClient", but TrimPrefixWord("Getaway", "Get") returns "Getaway".
+// The delimiters that follow w are removed too, so TrimPrefixWord("get_user", "get") returns "user".
+// If w consists of multiple words (such as "GetUser"), these words are all removed.
+func TrimPrefixWord(v, w string) string {
+	idxs, sub := wordIndexes(v), splitAll([]string{w})
+
+	if len(sub) == 0 || !hasWordsAt(wordsAt(v, idxs), sub, 0, equal) {
+		return v
+	}
+
+	if len(sub) == len(idxs) {
+		return ""
+	}
+
+	return v[idxs[len(sub)][0]:]
+}
+
+// TrimSuffixWord returns v without the trailing word w, comparing whole words (splitting on delimiters and on
+// "CamelCase" boundaries).
+// If v doesn't end with w, v is returned unmodified. The remainder of v isn't modified, so
+// TrimSuffixWord("UserCreatedEvent", "Event") returns "UserCreated", but TrimSuffixWord("Prevent", "Event") returns
+// "Prevent".
+// The delimiters that precede w are removed too, so TrimSuffixWord("user-created-event", "event") returns
+// "user-created".
+// If w consists of multiple words (such as "CreatedEvent"), these words are all removed.
+func TrimSuffixWord(v, w string) string {
+	idxs, sub := wordIndexes(v), splitAll([]string{w})
+
+	if len(sub) == 0 || !hasWordsAt(wordsAt(v, idxs), sub, len(idxs)-len(sub), equal) {
+		return v
+	}
+
+	if len(sub) == len(idxs) {
+		return ""
+	}
+
+	return v[:idxs[len(idxs)-len(sub)-1][1]]
+}
+
+// ReplaceWord returns a copy of v where each occurrence of the word old is replaced by new, comparing whole words
+// (ignoring case).
+// The words are joined again using the naming convention of v (see Detect), so ReplaceWord("userIdCache", "Id", "Key")
+// returns "userKeyCache" and ReplaceWord("user_id_cache", "Id", "Key") returns "user_key_cache".
+// If the naming convention of v can't be detected, the occurrences are replaced by new as-is, without modifying the
+// remainder of v.
+// Both old and new might consist of multiple words (such as "UserID").
+func ReplaceWord(v, old, new string) string {
+	style := Detect(v)
+
+	if style == Unknown {
+		return replaceWordsAt(v, SplitIndexes(v), splitAll([]string{old}), new)
+	}
+
+	sub := splitWords(old, nil)
+
+	if len(sub) == 0 {
+		return v
+	}
+
+	words, repl := splitWords(v, nil), splitWords(new, nil)
+	retVal := make([]string, 0, len(words))
+
+	for idx := 0; idx < len(words); {
+		if hasWordsAt(words, sub, idx, strings.EqualFold) {
+			retVal = append(retVal, repl...)
+			idx = idx + len(sub)
+
+			continue
+		}
+
+		retVal = append(retVal, words[idx])
+		idx = idx + 1
+	}
+
+	return Format(retVal, style)
+}
+
+// Returns a copy of v where each occurrence of sub (as consecutive words at the offsets in idxs) is replaced by repl.
+func replaceWordsAt(v string, idxs [][2]int, sub []string, repl string) string {
+	if len(sub) == 0 {
+		return v
+	}
+
+	var sb strings.Builder
+
+	words, last := wordsAt(v, idxs), 0
+
+	for idx := 0; idx < len(words); {
+		if hasWordsAt(words, sub, idx, strings.EqualFold) {
+			sb.WriteString(v[last:idxs[idx][0]])
+			sb.WriteString(repl)
+			last = idxs[idx+len(sub)-1][1]
+			idx = idx + len(sub)
+
+			continue
+		}
+
+		idx = idx + 1
+	}
+
+	sb.WriteString(v[last:])
+
+	return sb.String()
+}
+
+// Returns the words of v at the offsets in idxs (as returned by SplitIndexes).
+func wordsAt(v string, idxs [][2]int) []string {
+	retVal := make([]string, 0, len(idxs))
+
+	for _, idx := range idxs {
+		retVal = append(retVal, v[idx[0]:idx[1]])
+	}
+
+	return retVal
+}
+
+// Returns the words of each element of v (splitting on delimiters and on "CamelCase" boundaries), skipping empty
+// elements.
+func splitAll(v []string) []string {
+	retVal := make([]string, 0, len(v))
+
+	for _, w := range v {
+		if len(w) > 0 {
+			retVal = appendParsed(retVal, w)
+		}
+	}
+
+	return retVal
+}
+
+// CommonPrefixWords returns the leading words that all names have in common, so the common prefix of "ColorRed" and
+// "ColorGreen" is "Color".
+// The names are split into words on delimiters and on "CamelCase" boundaries, and the words are compared exactly.
+// If names is empty, or when the names don't have any leading words in common, an empty slice is returned.
+func CommonPrefixWords(names ...string) []string {
+	if len(names) == 0 {
+		return []string{}
+	}
+
+	retVal := splitWords(names[0], nil)
+
+	for _, name := range names[1:] {
+		words := splitWords(name, nil)
+		n := 0
+
+		for n < len(retVal) && n < len(words) && retVal[n] == words[n] {
+			n = n + 1
+		}
+
+		retVal = retVal[:n]
+	}
+
+	return retVal
+}
+
+// CommonSuffixWords returns the trailing words that all names have in common, so the common suffix of
+// "UserCreatedEvent" and "OrderCreatedEvent" is "Created" and "Event".
+// The names are split into words on delimiters and on "CamelCase" boundaries, and the words are compared exactly.
+// If names is empty, or when the names don't have any trailing words in common, an empty slice is returned.
+func CommonSuffixWords(names ...string) []string {
+	if len(names) == 0 {
+		return []string{}
+	}
+
+	retVal := splitWords(names[0], nil)
+
+	for _, name := range names[1:] {
+		words := splitWords(name, nil)
+		n := 0
+
+		for n < len(retVal) && n < len(words) && retVal[len(retVal)-1-n] == words[len(words)-1-n] {
+			n = n + 1
+		}
+
+		retVal = retVal[len(retVal)-n:]
+	}
+
+	return retVal
+}
+
+// EqualIdent reports whether or not a and b are the same identifier, regardless of their naming conventions.
+// Both are split into words (on delimiters and on "CamelCase" boundaries), which are compared ignoring case, so
+// "user_id", "UserID" and "userId" are all equal.
+// If a or b isn't a valid UTF-8 string, they are only equal when they are identical.
+func EqualIdent(a, b string) bool {
+	if !utf8.ValidString(a) || !utf8.ValidString(b) {
+		return a == b
+	}
+
+	aWords, bWords := splitWords(a, nil), splitWords(b, nil)
+
+	return len(aWords) == len(bWords) && hasWordsAt(aWords, bWords, 0, strings.EqualFold)
+}
+
+// Compare compares a and b word by word (ignoring case), and returns -1 if a < b, 0 if a == b and +1 if a > b.
+// Both are split into words (on delimiters and on "CamelCase" boundaries), so "HTTPServer" is ordered right after
+// "httpClient", instead of before all the strings starting with a lowercase rune.
+// Identifiers that have the same words (ignoring case) are ordered by comparing their bytes, so the order is
+// deterministic. Compare can be used with slices.SortFunc.
+func Compare(a, b string) int {
+	return compareIdents(a, b, compareFold)
+}
+
+// NaturalLess reports whether or not a is ordered before b, comparing them word by word (ignoring case) like Compare,
+// but comparing numbers by their value, so "File2" is ordered before "File10".
+// It can be used with sort.Slice.
+func NaturalLess(a, b string) bool {
+	return compareIdents(a, b, compareNatural) < 0
+}
+
+// Compares a and b word by word using cmp, and returns -1 if a < b, 0 if a == b and +1 if a > b.
+// Identifiers that have the same words (according to cmp) are ordered by comparing their bytes.
+func compareIdents(a, b string, cmp func(a, b string) int) int {
+	aWords, bWords := splitWords(a, nil), splitWords(b, nil)
+
+	for idx := 0; idx < len(aWords) && idx < len(bWords); idx++ {
+		if c := cmp(aWords[idx], bWords[idx]); c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case len(aWords) < len(bWords):
+		return -1
+	case len(aWords) > len(bWords):
+		return 1
+	}
+
+	return strings.Compare(a, b)
+}
+
+// Compares a and b ignoring case, and returns -1 if a < b, 0 if a == b and +1 if a > b.
+func compareFold(a, b string) int {
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+// Compares a and b like compareFold, except when both start with a number, in which case these numbers are compared by
+// their value first, after which the remainders (such as "nd" in "2nd") are compared like compareFold.
+func compareNatural(a, b string) int {
+	aNum, aRest := leadingNumber(a)
+	bNum, bRest := leadingNumber(b)
+
+	if len(aNum) == 0 || len(bNum) == 0 {
+		return compareFold(a, b)
+	}
+
+	// NOTE: Without leading zeros, the number with the most digits is the largest one.
+	aNum, bNum = strings.TrimLeft(aNum, "0"), strings.TrimLeft(bNum, "0")
+
+	if aLen, bLen := utf8.RuneCountInString(aNum), utf8.RuneCountInString(bNum); aLen != bLen {
+		if aLen < bLen {
+			return -1
+		}
+
+		return 1
+	}
+
+	if c := strings.Compare(aNum, bNum); c != 0 {
+		return c
+	}
+
+	return compareFold(aRest, bRest)
+}
+
+// Returns the digits at the start of v, and the remainder of v.
+func leadingNumber(v string) (string, string) {
+	eIdx := len(v)
+
+	for idx, r := range v {
+		if !isDigit(r) {
+			eIdx = idx
+
+			break
+		}
+	}
+
+	return v[:eIdx], v[eIdx:]
+}
+
+// ByWords implements sort.Interface, ordering identifiers using Compare.
+type ByWords []string
+
+// Len returns the number of identifiers in x.
+func (x ByWords) Len() int {
+	return len(x)
+}
+
+// Less reports whether or not the identifier at index i is ordered before the identifier at index j.
+func (x ByWords) Less(i, j int) bool {
+	return Compare(x[i], x[j]) < 0
+}
+
+// Swap swaps the identifiers at index i and j.
+func (x ByWords) Swap(i, j int) {
+	x[i], x[j] = x[j], x[i]
+}
+
+// Returns the index of the first occurrence of sub (as consecutive words) in words, or -1 if sub isn't found.
+// Words are compared using eq.
+func indexWords(words, sub []string, eq func(a, b string) bool) int {
+	for idx := 0; idx+len(sub) <= len(words); idx++ {
+		if hasWordsAt(words, sub, idx, eq) {
+			return idx
+		}
+	}
+
+	return -1
+}
+
+// Checks whether or not sub is found (as consecutive words) in words at index idx.
+// Words are compared using eq.
+func hasWordsAt(words, sub []string, idx int, eq func(a, b string) bool) bool {
+	if idx < 0 || idx+len(sub) > len(words) {
+		return false
+	}
+
+	for sIdx, w := range sub {
+		if !eq(words[idx+sIdx], w) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Checks whether or not a and b are equal.
+func equal(a, b string) bool {
+	return a == b
+}
@@ -0,0 +1,366 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Check whether or not a "CamelCase" string contains a word.
+func TestContainsWord(t *testing.T) {
+	for _, tc := range []struct {
+		vInput   string
+		vWord    string
+		want     bool
+		wantFold bool
+	}{
+		{vInput: "ParseHTMLDocument", vWord: "HTML", want: true, wantFold: true},
+		{vInput: "ParseHTMLDocument", vWord: "Html", want: false, wantFold: true},
+		{vInput: "ParseHtmlDocument", vWord: "HTML", want: false, wantFold: true},
+		{vInput: "ShtmlFile", vWord: "HTML", want: false, wantFold: false},
+		{vInput: "GetUserIDByName", vWord: "UserID", want: true, wantFold: true},
+		{vInput: "GetUserByID", vWord: "UserID", want: false, wantFold: false},
+		{vInput: "GetUserByID", vWord: "Getaway", want: false, wantFold: false},
+		{vInput: "user", vWord: "user", want: true, wantFold: true},
+		{vInput: "", vWord: "user", want: false, wantFold: false},
+		{vInput: "parse_html_document", vWord: "html", want: true, wantFold: true},
+		{vInput: "parse-html-document", vWord: "HTML", want: false, wantFold: true},
+		{vInput: "get_user_id_by_name", vWord: "UserId", want: false, wantFold: true},
+		{vInput: "get_user_id_by_name", vWord: "user_id", want: true, wantFold: true},
+		{vInput: "shtml_file", vWord: "html", want: false, wantFold: false},
+	} {
+		// ACT.
+		got := camelcase.ContainsWord(tc.vInput, tc.vWord)
+		gotFold := camelcase.ContainsWordFold(tc.vInput, tc.vWord)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Check whether or not a \"CamelCase\" string contains a word.\n"+
+			"Input:    %v (word: %v)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vWord, tc.want, got)
+
+		assert.Equal(t, gotFold, tc.wantFold, "", "\n\n"+
+			"UT Name:  Check whether or not a \"CamelCase\" string contains a word (ignoring case).\n"+
+			"Input:    %v (word: %v)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vWord, tc.wantFold, gotFold)
+	}
+}
+
+// UT: Check whether or not a "CamelCase" string starts (or ends) with words.
+func TestHasPrefixSuffixWords(t *testing.T) {
+	for _, tc := range []struct {
+		vInput     string
+		vWords     []string
+		wantPrefix bool
+		wantSuffix bool
+	}{
+		{vInput: "GetUserByID", vWords: []string{}, wantPrefix: true, wantSuffix: true},
+		{vInput: "GetUserByID", vWords: []string{"Get"}, wantPrefix: true, wantSuffix: false},
+		{vInput: "GetUserByID", vWords: []string{"Get", "User"}, wantPrefix: true, wantSuffix: false},
+		{vInput: "GetUserByID", vWords: []string{"GetUser"}, wantPrefix: true, wantSuffix: false},
+		{vInput: "GetUserByID", vWords: []string{"By", "ID"}, wantPrefix: false, wantSuffix: true},
+		{vInput: "Getaway", vWords: []string{"Get"}, wantPrefix: false, wantSuffix: false},
+		{vInput: "UserCreatedEvent", vWords: []string{"Created", "Event"}, wantPrefix: false, wantSuffix: true},
+		{vInput: "Prevent", vWords: []string{"Event"}, wantPrefix: false, wantSuffix: false},
+		{vInput: "Event", vWords: []string{"User", "Event"}, wantPrefix: false, wantSuffix: false},
+		{vInput: "get_user_by_id", vWords: []string{"get"}, wantPrefix: true, wantSuffix: false},
+		{vInput: "get_user_by_id", vWords: []string{"get_user"}, wantPrefix: true, wantSuffix: false},
+		{vInput: "get_user_by_id", vWords: []string{"by", "id"}, wantPrefix: false, wantSuffix: true},
+		{vInput: "user-created-event", vWords: []string{"created-event"}, wantPrefix: false, wantSuffix: true},
+		{vInput: "get_away", vWords: []string{"getaway"}, wantPrefix: false, wantSuffix: false},
+	} {
+		// ACT.
+		gotPrefix := camelcase.HasPrefixWords(tc.vInput, tc.vWords...)
+		gotSuffix := camelcase.HasSuffixWords(tc.vInput, tc.vWords...)
+
+		// ASSERT.
+		assert.Equal(t, gotPrefix, tc.wantPrefix, "", "\n\n"+
+			"UT Name:  Check whether or not a \"CamelCase\" string starts with words.\n"+
+			"Input:    %v (words: %v)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vWords, tc.wantPrefix, gotPrefix)
+
+		assert.Equal(t, gotSuffix, tc.wantSuffix, "", "\n\n"+
+			"UT Name:  Check whether or not a \"CamelCase\" string ends with words.\n"+
+			"Input:    %v (words: %v)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vWords, tc.wantSuffix, gotSuffix)
+	}
+}
+
+// UT: Remove a leading (or trailing) word from a "CamelCase" string.
+func TestTrimPrefixSuffixWord(t *testing.T) {
+	for _, tc := range []struct {
+		vInput     string
+		vWord      string
+		wantPrefix string
+		wantSuffix string
+	}{
+		{vInput: "GetHTTPClient", vWord: "", wantPrefix: "GetHTTPClient", wantSuffix: "GetHTTPClient"},
+		{vInput: "GetHTTPClient", vWord: "Get", wantPrefix: "HTTPClient", wantSuffix: "GetHTTPClient"},
+		{vInput: "GetHTTPClient", vWord: "Client", wantPrefix: "GetHTTPClient", wantSuffix: "GetHTTP"},
+		{vInput: "GetHTTPClient", vWord: "GetHTTP", wantPrefix: "Client", wantSuffix: "GetHTTPClient"},
+		{vInput: "Getaway", vWord: "Get", wantPrefix: "Getaway", wantSuffix: "Getaway"},
+		{vInput: "UserCreatedEvent", vWord: "CreatedEvent", wantPrefix: "UserCreatedEvent", wantSuffix: "User"},
+		{vInput: "Prevent", vWord: "Event", wantPrefix: "Prevent", wantSuffix: "Prevent"},
+		{vInput: "Event", vWord: "Event", wantPrefix: "", wantSuffix: ""},
+		{vInput: "getUser", vWord: "get", wantPrefix: "User", wantSuffix: "getUser"},
+		{vInput: "get_user", vWord: "get", wantPrefix: "user", wantSuffix: "get_user"},
+		{vInput: "get_user", vWord: "user", wantPrefix: "get_user", wantSuffix: "get"},
+		{vInput: "user-created-event", vWord: "created-event", wantPrefix: "user-created-event", wantSuffix: "user"},
+		{vInput: "user-created-event", vWord: "user_created", wantPrefix: "event", wantSuffix: "user-created-event"},
+		{vInput: "get__user", vWord: "get", wantPrefix: "user", wantSuffix: "get__user"},
+		{vInput: "getaway_home", vWord: "get", wantPrefix: "getaway_home", wantSuffix: "getaway_home"},
+	} {
+		// ACT.
+		gotPrefix := camelcase.TrimPrefixWord(tc.vInput, tc.vWord)
+		gotSuffix := camelcase.TrimSuffixWord(tc.vInput, tc.vWord)
+
+		// ASSERT.
+		assert.Equal(t, gotPrefix, tc.wantPrefix, "", "\n\n"+
+			"UT Name:  Remove a leading word from a \"CamelCase\" string.\n"+
+			"Input:    %v (word: %v)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vWord, tc.wantPrefix, gotPrefix)
+
+		assert.Equal(t, gotSuffix, tc.wantSuffix, "", "\n\n"+
+			"UT Name:  Remove a trailing word from a \"CamelCase\" string.\n"+
+			"Input:    %v (word: %v)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vWord, tc.wantSuffix, gotSuffix)
+	}
+}
+
+// UT: Replace a word in a string, preserving the naming convention of the string.
+func TestReplaceWord(t *testing.T) {
+	for _, tc := range []struct {
+		vInput string
+		vOld   string
+		vNew   string
+		want   string
+	}{
+		{vInput: "userIdCache", vOld: "Id", vNew: "Key", want: "userKeyCache"},
+		{vInput: "userIDCache", vOld: "ID", vNew: "Key", want: "userKeyCache"},
+		{vInput: "UserIdCache", vOld: "user", vNew: "Account", want: "AccountIdCache"},
+		{vInput: "userIdCache", vOld: "user", vNew: "Account", want: "accountIdCache"},
+		{vInput: "user_id_cache", vOld: "Id", vNew: "Key", want: "user_key_cache"},
+		{vInput: "USER_ID_CACHE", vOld: "Id", vNew: "AccessKey", want: "USER_ACCESS_KEY_CACHE"},
+		{vInput: "user-id-user", vOld: "user", vNew: "Group", want: "group-id-group"},
+		{vInput: "userIdCache", vOld: "IdCache", vNew: "", want: "user"},
+		{vInput: "validIdentifier", vOld: "Id", vNew: "Key", want: "validIdentifier"},
+		{vInput: "userIdCache", vOld: "", vNew: "Key", want: "userIdCache"},
+		{vInput: "user_id.Cache", vOld: "Cache", vNew: "Store", want: "user_id.Store"},
+	} {
+		// ACT.
+		got := camelcase.ReplaceWord(tc.vInput, tc.vOld, tc.vNew)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Replace a word in a string, preserving the naming convention of the string.\n"+
+			"Input:    %v (%v → %v)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vOld, tc.vNew, tc.want, got)
+	}
+}
+
+// UT: Compare identifiers regardless of their naming conventions.
+func TestEqualIdent(t *testing.T) {
+	for _, tc := range []struct {
+		vA   string
+		vB   string
+		want bool
+	}{
+		{vA: "", vB: "", want: true},
+		{vA: "user_id", vB: "UserID", want: true},
+		{vA: "userId", vB: "UserID", want: true},
+		{vA: "user-id", vB: "USER_ID", want: true},
+		{vA: "userID", vB: "user", want: false},
+		{vA: "userid", vB: "userId", want: false},
+		{vA: "HTTPServer", vB: "http.server", want: true},
+		{vA: "BadUTF8\xe2", vB: "BadUTF8\xe2", want: true},
+		{vA: "BadUTF8\xe2", vB: "bad_utf8\xe2", want: false},
+	} {
+		// ACT.
+		got := camelcase.EqualIdent(tc.vA, tc.vB)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Compare identifiers regardless of their naming conventions.\n"+
+			"Input:    %q, %q\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vA, tc.vB, tc.want, got)
+	}
+}
+
+// UT: Compare identifiers word by word.
+func TestCompare(t *testing.T) {
+	for _, tc := range []struct {
+		vA   string
+		vB   string
+		want int
+	}{
+		{vA: "", vB: "", want: 0},
+		{vA: "httpClient", vB: "HTTPServer", want: -1},
+		{vA: "HTTPServer", vB: "httpClient", want: 1},
+		{vA: "HTTPServer", vB: "HttpsServer", want: -1},
+		{vA: "user", vB: "userID", want: -1},
+		{vA: "user_id", vB: "UserID", want: 1},
+		{vA: "UserID", vB: "UserID", want: 0},
+	} {
+		// ACT.
+		got := camelcase.Compare(tc.vA, tc.vB)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Compare identifiers word by word.\n"+
+			"Input:    %q, %q\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vA, tc.vB, tc.want, got)
+	}
+}
+
+// UT: Sort identifiers word by word.
+func TestSortByWords(t *testing.T) {
+	// ARRANGE.
+	input := []string{"HTTPServer", "apiKey", "httpClient", "UserID", "user_id", "Http", "APIVersion"}
+	want := []string{"apiKey", "APIVersion", "Http", "httpClient", "HTTPServer", "UserID", "user_id"}
+
+	// ACT.
+	gotSort := slices.Clone(input)
+	gotSortFunc := slices.Clone(input)
+
+	sort.Sort(camelcase.ByWords(gotSort))
+	slices.SortFunc(gotSortFunc, camelcase.Compare)
+
+	// ASSERT.
+	assert.EqualS(t, gotSort, want, "", "\n\n"+
+		"UT Name:  Sort identifiers word by word (using sort.Sort).\n"+
+		"Input:    %v\n"+
+		"\033[32mExpected: %v\033[0m\n"+
+		"\033[31mActual:   %v\033[0m\n\n", input, want, gotSort)
+
+	assert.EqualS(t, gotSortFunc, want, "", "\n\n"+
+		"UT Name:  Sort identifiers word by word (using slices.SortFunc).\n"+
+		"Input:    %v\n"+
+		"\033[32mExpected: %v\033[0m\n"+
+		"\033[31mActual:   %v\033[0m\n\n", input, want, gotSortFunc)
+}
+
+// UT: Sort identifiers in natural order.
+func TestNaturalLess(t *testing.T) {
+	// ARRANGE.
+	input := []string{"File10", "file2", "File1", "File02Backup", "File2Backup", "Version1_10", "Version1_9", "Disk"}
+	want := []string{"Disk", "File1", "file2", "File02Backup", "File2Backup", "File10", "Version1_9", "Version1_10"}
+
+	// ACT.
+	got := slices.Clone(input)
+
+	sort.Slice(got, func(i, j int) bool { return camelcase.NaturalLess(got[i], got[j]) })
+
+	// ASSERT.
+	assert.EqualS(t, got, want, "", "\n\n"+
+		"UT Name:  Sort identifiers in natural order.\n"+
+		"Input:    %v\n"+
+		"\033[32mExpected: %v\033[0m\n"+
+		"\033[31mActual:   %v\033[0m\n\n", input, want, got)
+}
+
+// UT: Sort identifiers containing ordinal words in natural order.
+func TestNaturalLessOrdinals(t *testing.T) {
+	// ARRANGE.
+	input := []string{"File10", "File2nd", "File11th", "File1st", "File3rd", "File2", "File02nd"}
+	want := []string{"File1st", "File2", "File02nd", "File2nd", "File3rd", "File10", "File11th"}
+
+	// ACT.
+	got := slices.Clone(input)
+
+	sort.Slice(got, func(i, j int) bool { return camelcase.NaturalLess(got[i], got[j]) })
+
+	// ASSERT.
+	assert.EqualS(t, got, want, "", "\n\n"+
+		"UT Name:  Sort identifiers containing ordinal words in natural order.\n"+
+		"Input:    %v\n"+
+		"\033[32mExpected: %v\033[0m\n"+
+		"\033[31mActual:   %v\033[0m\n\n", input, want, got)
+}
+
+// UT: Find the leading (and trailing) words that identifiers have in common.
+func TestCommonPrefixSuffixWords(t *testing.T) {
+	for _, tc := range []struct {
+		vNames     []string
+		wantPrefix []string
+		wantSuffix []string
+	}{
+		{vNames: []string{}, wantPrefix: []string{}, wantSuffix: []string{}},
+		{vNames: []string{"ColorRed"}, wantPrefix: []string{"Color", "Red"}, wantSuffix: []string{"Color", "Red"}},
+		{
+			vNames:     []string{"ColorRed", "ColorGreen", "ColorDarkBlue"},
+			wantPrefix: []string{"Color"},
+			wantSuffix: []string{},
+		},
+		{
+			vNames:     []string{"UserCreatedEvent", "OrderCreatedEvent", "order_created_event"},
+			wantPrefix: []string{},
+			wantSuffix: []string{},
+		},
+		{
+			vNames:     []string{"UserCreatedEvent", "OrderCreatedEvent"},
+			wantPrefix: []string{},
+			wantSuffix: []string{"Created", "Event"},
+		},
+		{
+			vNames:     []string{"HTTPStatusOK", "HTTPStatusNotFound"},
+			wantPrefix: []string{"HTTP", "Status"},
+			wantSuffix: []string{},
+		},
+		{vNames: []string{"Color", "ColorRed"}, wantPrefix: []string{"Color"}, wantSuffix: []string{}},
+	} {
+		// ACT.
+		gotPrefix := camelcase.CommonPrefixWords(tc.vNames...)
+		gotSuffix := camelcase.CommonSuffixWords(tc.vNames...)
+
+		// ASSERT.
+		assert.EqualS(t, gotPrefix, tc.wantPrefix, "", "\n\n"+
+			"UT Name:  Find the leading words that identifiers have in common.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vNames, tc.wantPrefix, gotPrefix)
+
+		assert.EqualS(t, gotSuffix, tc.wantSuffix, "", "\n\n"+
+			"UT Name:  Find the trailing words that identifiers have in common.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vNames, tc.wantSuffix, gotSuffix)
+	}
+}
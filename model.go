@@ -0,0 +1,155 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"strings"
+	"unicode/utf8"
+)
+
+// A Model holds the relative frequencies of words, which are used to segment a string into its most likely words
+// (such as "experts", "exchange" for "expertsexchange", rather than "expert", "sex", "change").
+// The frequencies are modelled after Zipf's law, so the word at position idx (in a list of n words that's ordered
+// from the most to the least frequently used word) has a probability of 1 / ((idx + 1) * H(n)), with H(n) being the
+// n-th harmonic number.
+// A Model is safe for concurrent use.
+type Model struct {
+	costs   map[string]float64 // The cost (the negative log probability) of each word.
+	maxLen  int                // The length (in bytes) of the longest word.
+	unknown float64            // The cost of a rune that isn't part of a known word.
+}
+
+// NewModel returns a Model for words, which are ordered from the most to the least frequently used word.
+// The words are converted to lowercase. When a word is listed multiple times, its first position is used.
+func NewModel(words []string) *Model {
+	m := &Model{costs: make(map[string]float64, len(words))}
+	harmonic := 0.0
+
+	for idx := range words {
+		harmonic = harmonic + 1/float64(idx+1)
+	}
+
+	for idx, w := range words {
+		w = strings.ToLower(w)
+
+		if _, ok := m.costs[w]; ok || len(w) == 0 {
+			continue
+		}
+
+		m.costs[w] = math.Log(float64(idx+1)) + math.Log(harmonic)
+		m.maxLen = max(m.maxLen, len(w))
+	}
+
+	// NOTE: A rune that isn't part of a known word is less likely than any word in the model.
+	m.unknown = math.Log(float64(len(words)+1)) + math.Log(harmonic) + 1
+
+	return m
+}
+
+// ReadModel reads the words of a Model from r and returns the Model.
+// The words are read one word per line, ordered from the most to the least frequently used word. Leading and trailing
+// whitespace is removed, and empty lines and lines starting with '#' are ignored.
+func ReadModel(r io.Reader) (*Model, error) {
+	words := make([]string, 0)
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		if w := strings.TrimSpace(scanner.Text()); len(w) > 0 && !strings.HasPrefix(w, "#") {
+			words = append(words, w)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return NewModel(words), nil
+}
+
+// Returns the most likely words of v (the segmentation whose words have the lowest total cost), or nil if v can't be
+// segmented.
+// Runes that aren't part of a known word are combined into a single word.
+func (m *Model) segment(v string) []string {
+	key := v // The lowercase version of v, used to look up the words.
+
+	if hasUpper(v) {
+		// NOTE: The words of key are mapped onto v, which requires both to have the same length.
+		if key = strings.ToLower(v); len(key) != len(v) {
+			return nil
+		}
+	}
+
+	cost := make([]float64, len(v)+1) // The lowest total cost of the words of v[idx:].
+	next := make([]int, len(v)+1)     // The position (in v) where the first word of that segmentation ends.
+
+	for sIdx := len(v) - 1; sIdx >= 0; sIdx-- {
+		if !utf8.RuneStart(v[sIdx]) {
+			continue
+		}
+
+		_, size := utf8.DecodeRuneInString(v[sIdx:])
+		cost[sIdx], next[sIdx] = cost[sIdx+size]+m.unknown, sIdx+size
+
+		for eIdx := sIdx + 1; eIdx <= len(v) && eIdx-sIdx <= m.maxLen; eIdx++ {
+			if eIdx < len(v) && !utf8.RuneStart(v[eIdx]) {
+				continue
+			}
+
+			if c, ok := m.costs[key[sIdx:eIdx]]; ok && c+cost[eIdx] <= cost[sIdx] {
+				cost[sIdx], next[sIdx] = c+cost[eIdx], eIdx
+			}
+		}
+	}
+
+	retVal := make([]string, 0)
+	uIdx := -1 // The position (in v) where the current sequence of unknown runes starts.
+
+	for sIdx := 0; sIdx < len(v); sIdx = next[sIdx] {
+		if _, ok := m.costs[key[sIdx:next[sIdx]]]; !ok {
+			if uIdx < 0 {
+				uIdx = sIdx
+			}
+
+			continue
+		}
+
+		if uIdx >= 0 {
+			retVal = append(retVal, v[uIdx:sIdx])
+			uIdx = -1
+		}
+
+		retVal = append(retVal, v[sIdx:next[sIdx]])
+	}
+
+	if uIdx >= 0 {
+		retVal = append(retVal, v[uIdx:])
+	}
+
+	return retVal
+}
@@ -0,0 +1,90 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Split a string into a slice of words using a Splitter with a Model.
+func TestSplitterSplitWithModel(t *testing.T) {
+	// ARRANGE.
+	model := camelcase.NewModel([]string{"the", "change", "sex", "expert", "exchange", "experts", "Parse", "file"})
+
+	for _, tc := range []struct {
+		vInput string
+		vOpts  []camelcase.Option
+		want   []string
+	}{
+		{vInput: "expertsexchange", want: []string{"experts", "exchange"}},
+		{vInput: "parsefile_ParseFile", want: []string{"parse", "file", "Parse", "File"}},
+		{vInput: "theqxfilezz", want: []string{"the", "qx", "file", "zz"}},
+		{vInput: "qx", want: []string{"qx"}},
+		{
+			vInput: "parsefile",
+			vOpts:  []camelcase.Option{camelcase.WithDictionary("pars", "efile")},
+			want:   []string{"parse", "file"},
+		},
+	} {
+		// ACT.
+		got := camelcase.New(append(tc.vOpts, camelcase.WithModel(model))...).Split(tc.vInput)
+
+		// ASSERT.
+		assert.EqualS(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Split a string into a slice of words using a Splitter with a Model.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Read a Model.
+func TestReadModel(t *testing.T) {
+	// ARRANGE.
+	input := "# Words, ordered by frequency.\n\nread\n  model \nread\nreadmodel\n"
+	want := []string{"readmodel", "model", "read"}
+
+	// ACT.
+	model, err := camelcase.ReadModel(strings.NewReader(input))
+
+	// ASSERT.
+	assert.Equal(t, err, nil, "", "\n\n"+
+		"UT Name:  Read a Model.\n"+
+		"\033[32mExpected (error): %v\033[0m\n"+
+		"\033[31mActual (error):   %v\033[0m\n\n", nil, err)
+
+	got := camelcase.New(camelcase.WithModel(model)).Split("readmodelmodelread")
+
+	assert.EqualS(t, got, want, "", "\n\n"+
+		"UT Name:  Read a Model.\n"+
+		"\033[32mExpected: %v\033[0m\n"+
+		"\033[31mActual:   %v\033[0m\n\n", want, got)
+}
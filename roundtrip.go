@@ -0,0 +1,68 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import (
+	"strconv"
+	"strings"
+)
+
+// RoundTripError is returned when the words of a string don't reassemble into that string.
+type RoundTripError struct {
+	Input  string // The string that was split.
+	Output string // The reassembled words.
+	Offset int    // The offset (in bytes) of the first byte that differs.
+}
+
+// Error returns a description of e.
+func (e *RoundTripError) Error() string {
+	return "camelcase: words of " + strconv.Quote(e.Input) + " reassemble into " + strconv.Quote(e.Output) +
+		" (differs at offset " + strconv.Itoa(e.Offset) + ")"
+}
+
+// Reassemble concatenates words into a single string, without any modification.
+// Since Split doesn't drop any runes, Reassemble(Split(v)) equals v for each string v.
+func Reassemble(words []string) string {
+	return strings.Join(words, "")
+}
+
+// Verify checks that the words Split returns for v reassemble into v (see Reassemble), so the invariant can be
+// asserted in fuzz tests and property-based tests.
+// If the words don't reassemble into v, a *RoundTripError is returned.
+// Each word in noSplit (if provided) is treated as a word that shouldn't be split.
+func Verify(v string, noSplit ...string) error {
+	if output := Reassemble(Split(v, noSplit...)); output != v {
+		offset := 0
+
+		for offset < len(v) && offset < len(output) && v[offset] == output[offset] {
+			offset = offset + 1
+		}
+
+		return &RoundTripError{Input: v, Output: output, Offset: offset}
+	}
+
+	return nil
+}
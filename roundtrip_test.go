@@ -0,0 +1,110 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Reassemble a slice of words into a single string.
+func TestReassemble(t *testing.T) {
+	for _, tc := range []struct {
+		vInput []string
+		want   string
+	}{
+		{vInput: nil, want: ""},
+		{vInput: []string{"get", "HTTP", "Server"}, want: "getHTTPServer"},
+		{vInput: []string{"MY", "_VALUE"}, want: "MY_VALUE"},
+	} {
+		// ACT.
+		got := camelcase.Reassemble(tc.vInput)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Reassemble a slice of words into a single string.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Verify that the words of a string reassemble into that string.
+func TestVerify(t *testing.T) {
+	for _, tc := range []struct {
+		vInput   string
+		vNoSplit []string
+	}{
+		{vInput: ""},
+		{vInput: "parseHTTPHeader_v2 "},
+		{vInput: "OAuth2Token", vNoSplit: []string{"OAuth2"}},
+		{vInput: "caféBar"},
+		{vInput: "BadUTF8\xe2\xe2\xa1"},
+	} {
+		// ACT.
+		err := camelcase.Verify(tc.vInput, tc.vNoSplit...)
+
+		// ASSERT.
+		assert.Equal(t, err, nil, "", "\n\n"+
+			"UT Name:  Verify that the words of a string reassemble into that string.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, nil, err)
+	}
+}
+
+// UT: Get the description of a RoundTripError.
+func TestRoundTripErrorError(t *testing.T) {
+	// ARRANGE.
+	err := &camelcase.RoundTripError{Input: "getUser", Output: "getuser", Offset: 3}
+	want := `camelcase: words of "getUser" reassemble into "getuser" (differs at offset 3)`
+
+	// ACT.
+	got := err.Error()
+
+	// ASSERT.
+	assert.Equal(t, got, want, "", "\n\n"+
+		"UT Name:  Get the description of a RoundTripError.\n"+
+		"Input:    %v\n"+
+		"\033[32mExpected: %v\033[0m\n"+
+		"\033[31mActual:   %v\033[0m\n\n", err, want, got)
+}
+
+// Fuzz: Verify that the words of a string reassemble into that string.
+func FuzzVerify(f *testing.F) {
+	for _, v := range []string{"", "getHTTPServer2nd", "MY_VALUE", "OAuth2Token", "caféBar", "BadUTF8\xe2\xe2\xa1"} {
+		f.Add(v)
+	}
+
+	f.Fuzz(func(t *testing.T, v string) {
+		if err := camelcase.Verify(v); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -0,0 +1,210 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import (
+	"bufio"
+	"io"
+	"unicode"
+	"unicode/utf8"
+	"unsafe"
+)
+
+// Scanner reads the words of a "CamelCase" string from an io.Reader.
+// The input is read incrementally, so (unlike Split) it doesn't require the whole string, nor a slice holding all of
+// its words, to be kept in memory.
+type Scanner struct {
+	scanner *bufio.Scanner
+}
+
+// NewScanner returns a Scanner that reads the words of the "CamelCase" string that's read from r.
+// It produces the same words as Split, except that an empty input doesn't produce any words.
+// Each word in noSplit (if provided) is treated as a word that shouldn't be split.
+func NewScanner(r io.Reader, noSplit ...string) *Scanner {
+	scanner := bufio.NewScanner(r)
+	scanner.Split(splitFunc(noSplit))
+
+	return &Scanner{scanner: scanner}
+}
+
+// Buffer sets the initial buffer to use when scanning and the maximum size (in bytes) of a word.
+// It must be called before the first call to Scan.
+// By default, the maximum size of a word is bufio.MaxScanTokenSize.
+func (s *Scanner) Buffer(buf []byte, max int) {
+	s.scanner.Buffer(buf, max)
+}
+
+// Scan advances s to the next word, which is then available through Text (or Bytes).
+// It returns false when there are no more words, or when an error occurred (which is returned by Err).
+// If the input isn't a valid UTF-8 string, scanning stops at the first invalid UTF-8 sequence.
+func (s *Scanner) Scan() bool {
+	return s.scanner.Scan()
+}
+
+// Text returns the word that was read by the last call to Scan.
+func (s *Scanner) Text() string {
+	return s.scanner.Text()
+}
+
+// Bytes returns the word that was read by the last call to Scan.
+// The underlying array may be overwritten by a subsequent call to Scan.
+func (s *Scanner) Bytes() []byte {
+	return s.scanner.Bytes()
+}
+
+// Err returns the first error (other than io.EOF) that was encountered by s.
+// If the input isn't a valid UTF-8 string, the error is an *InvalidUTF8Error.
+func (s *Scanner) Err() error {
+	return s.scanner.Err()
+}
+
+// ScanWords is a bufio.SplitFunc that returns each word of each space-separated "CamelCase" string, with the
+// surrounding spaces deleted.
+// The words of each string are the same words as the ones returned by Split, and spaces are defined by
+// unicode.IsSpace, just like bufio.ScanWords.
+// A string that isn't a valid UTF-8 string is returned as a single word.
+// It never returns an empty word.
+// Each space-separated string is read completely before it's split, so it can't be longer than the maximum token size
+// of the bufio.Scanner.
+func ScanWords(data []byte, atEOF bool) (int, []byte, error) {
+	n := len(data)
+
+	// NOTE: A rune that's cut off at the end of data is only read when the remainder of that rune is available.
+	if !atEOF {
+		n = fullRunesLen(data)
+	}
+
+	// Skip the leading spaces.
+	sIdx := 0
+
+	for sIdx < n {
+		r, size := utf8.DecodeRune(data[sIdx:n])
+
+		if !unicode.IsSpace(r) {
+			break
+		}
+
+		sIdx = sIdx + size
+	}
+
+	// Find the end of the string.
+	eIdx := sIdx
+
+	for eIdx < n {
+		r, size := utf8.DecodeRune(data[eIdx:n])
+
+		if unicode.IsSpace(r) {
+			break
+		}
+
+		eIdx = eIdx + size
+	}
+
+	if sIdx == eIdx {
+		return sIdx, nil, nil
+	}
+
+	// NOTE: A string is only split once it's complete, since its remainder might change the words (or its validity).
+	if eIdx == n && !atEOF {
+		return sIdx, nil, nil
+	}
+
+	// NOTE: The string shares its memory with data, which is safe since it's only used for reading while splitting.
+	v := unsafe.String(&data[sIdx], eIdx-sIdx)
+
+	if !utf8.ValidString(v) {
+		return eIdx, data[sIdx:eIdx], nil
+	}
+
+	vRdr := &rdr{input: v}
+	vRdr.readNextPart()
+
+	return sIdx + vRdr.pos, data[sIdx : sIdx+vRdr.pos], nil
+}
+
+// Returns a bufio.SplitFunc that splits its input into words.
+// Each word in noSplit is treated as a word that shouldn't be split.
+func splitFunc(noSplit []string) bufio.SplitFunc {
+	t := newTrie(noSplit, false)
+	offset := 0    // The offset (in bytes) of the data that's passed to the function in the input.
+	validated := 0 // The offset (in bytes) in the input up to which the input is known to be valid UTF-8.
+
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		n := len(data)
+
+		// NOTE: A rune that's cut off at the end of data is only read when the remainder of that rune is available.
+		if !atEOF {
+			n = fullRunesLen(data)
+		}
+
+		if n == 0 {
+			return 0, nil, nil
+		}
+
+		// NOTE: The string shares its memory with data, which is safe since it's only used for reading while splitting.
+		v := unsafe.String(unsafe.SliceData(data), n)
+
+		// NOTE: Only the data that wasn't validated before is validated, since the same data is passed multiple times.
+		if idx := invalidUTF8Offset(v[validated-offset:]); idx >= 0 {
+			return 0, nil, &InvalidUTF8Error{Offset: validated + idx}
+		}
+
+		validated = offset + n
+
+		vRdr := &rdr{input: v, noSplit: t}
+		vRdr.readNextPart()
+
+		// NOTE: A word that ends at the end of data might continue in the data that isn't read yet.
+		if vRdr.pos == n && !atEOF {
+			return 0, nil, nil
+		}
+
+		// NOTE: The ordinal suffix of a number (such as "nd" in "2nd") can only be recognized when the suffix and the
+		//       rune that follows it are available.
+		if r, _ := utf8.DecodeRune(data); isDigit(r) && n-vRdr.pos < 3 && !atEOF {
+			return 0, nil, nil
+		}
+
+		offset = offset + vRdr.pos
+
+		return vRdr.pos, data[:vRdr.pos], nil
+	}
+}
+
+// Returns the length (in bytes) of data, without the rune that's cut off at the end of data (if any).
+func fullRunesLen(data []byte) int {
+	for idx := len(data) - 1; idx >= 0 && idx >= len(data)-utf8.UTFMax; idx-- {
+		if utf8.RuneStart(data[idx]) {
+			if !utf8.FullRune(data[idx:]) {
+				return idx
+			}
+
+			break
+		}
+	}
+
+	return len(data)
+}
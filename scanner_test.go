@@ -0,0 +1,217 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"bufio"
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Read the words of a "CamelCase" string from an io.Reader.
+func TestScanner(t *testing.T) {
+	for _, tc := range []struct {
+		vInput   string
+		vNoSplit []string
+		want     []string
+	}{
+		{
+			vInput: "",
+			want:   []string{},
+		},
+		{
+			vInput: "PDFLoader",
+			want:   []string{"PDF", "Loader"},
+		},
+		{
+			vInput: "HelloWorld99HTMLParser",
+			want:   []string{"Hello", "World", "99", "HTML", "Parser"},
+		},
+		{
+			vInput:   "1Tls2IsUsed",
+			vNoSplit: []string{"Tls2"},
+			want:     []string{"1", "Tls2", "Is", "Used"},
+		},
+		{
+			vInput: "ÜberWichtigСловоÉcole",
+			want:   []string{"Über", "Wichtig", "Слово", "École"},
+		},
+		{
+			vInput: "2ndGeneration3rd4th1stly",
+			want:   []string{"2nd", "Generation", "3rd", "4th", "1", "stly"},
+		},
+	} {
+		for _, oneByte := range []bool{false, true} {
+			// ARRANGE.
+			rdr := strings.NewReader(tc.vInput)
+			var scanner *camelcase.Scanner
+
+			if oneByte {
+				scanner = camelcase.NewScanner(iotest.OneByteReader(rdr), tc.vNoSplit...)
+			} else {
+				scanner = camelcase.NewScanner(rdr, tc.vNoSplit...)
+			}
+
+			// ACT.
+			got := make([]string, 0)
+
+			for scanner.Scan() {
+				got = append(got, scanner.Text())
+			}
+
+			// ASSERT.
+			assert.EqualS(t, got, tc.want, "", "\n\n"+
+				"UT Name:  Read the words of a \"CamelCase\" string from an io.Reader.\n"+
+				"Input:    %v (one byte at a time: %v)\n"+
+				"\033[32mExpected: %v\033[0m\n"+
+				"\033[31mActual:   %v\033[0m\n\n", tc.vInput, oneByte, tc.want, got)
+
+			assert.Equal(t, scanner.Err(), nil, "", "\n\n"+
+				"UT Name:  Read the words of a \"CamelCase\" string from an io.Reader.\n"+
+				"Input:    %v (one byte at a time: %v)\n"+
+				"\033[32mExpected: %v\033[0m\n"+
+				"\033[31mActual:   %v\033[0m\n\n", tc.vInput, oneByte, nil, scanner.Err())
+		}
+	}
+}
+
+// UT: A Scanner stops at the first invalid UTF-8 sequence.
+func TestScannerInvalidUTF8(t *testing.T) {
+	// ARRANGE.
+	scanner := camelcase.NewScanner(iotest.OneByteReader(strings.NewReader("ValidWord\x80Invalid")))
+
+	// ACT.
+	got := make([]string, 0)
+
+	for scanner.Scan() {
+		got = append(got, scanner.Text())
+	}
+
+	// ASSERT.
+	var utf8Err *camelcase.InvalidUTF8Error
+
+	ok := errors.As(scanner.Err(), &utf8Err)
+
+	assert.EqualS(t, got, []string{"Valid"}, "", "\n\n"+
+		"UT Name:  A Scanner returns the words before the first invalid UTF-8 sequence.\n"+
+		"\033[32mExpected: %v\033[0m\n"+
+		"\033[31mActual:   %v\033[0m\n\n", []string{"Valid"}, got)
+
+	assert.Equal(t, ok, true, "", "\n\n"+
+		"UT Name:  The error returned by a Scanner is an *InvalidUTF8Error.\n"+
+		"\033[32mExpected: %v\033[0m\n"+
+		"\033[31mActual:   %v\033[0m\n\n", true, ok)
+
+	assert.Equal(t, utf8Err.Offset, 9, "", "\n\n"+
+		"UT Name:  The error returned by a Scanner holds the offset of the first invalid UTF-8 sequence.\n"+
+		"\033[32mExpected: %v\033[0m\n"+
+		"\033[31mActual:   %v\033[0m\n\n", 9, utf8Err.Offset)
+}
+
+// UT: Read the words of space-separated "CamelCase" strings using a bufio.Scanner.
+func TestScanWords(t *testing.T) {
+	for _, tc := range []struct {
+		vInput string
+		want   []string
+	}{
+		{
+			vInput: "",
+			want:   []string{},
+		},
+		{
+			vInput: " \t\n ",
+			want:   []string{},
+		},
+		{
+			vInput: "PDFLoader",
+			want:   []string{"PDF", "Loader"},
+		},
+		{
+			vInput: "  HelloWorld\tGL11Version\n\u3000ÜberWichtig  ",
+			want:   []string{"Hello", "World", "GL", "11", "Version", "Über", "Wichtig"},
+		},
+		{
+			vInput: "ValidWord BadUTF8\xe2\xe2\xa1 OtherWord",
+			want:   []string{"Valid", "Word", "BadUTF8\xe2\xe2\xa1", "Other", "Word"},
+		},
+	} {
+		for _, oneByte := range []bool{false, true} {
+			// ARRANGE.
+			rdr := strings.NewReader(tc.vInput)
+			scanner := bufio.NewScanner(rdr)
+
+			if oneByte {
+				scanner = bufio.NewScanner(iotest.OneByteReader(rdr))
+			}
+
+			scanner.Split(camelcase.ScanWords)
+
+			// ACT.
+			got := make([]string, 0)
+
+			for scanner.Scan() {
+				got = append(got, scanner.Text())
+			}
+
+			// ASSERT.
+			assert.EqualS(t, got, tc.want, "", "\n\n"+
+				"UT Name:  Read the words of space-separated \"CamelCase\" strings using a bufio.Scanner.\n"+
+				"Input:    %q (one byte at a time: %v)\n"+
+				"\033[32mExpected: %q\033[0m\n"+
+				"\033[31mActual:   %q\033[0m\n\n", tc.vInput, oneByte, tc.want, got)
+		}
+	}
+}
+
+// Benchmark: Read the words of a "CamelCase" string from an io.Reader.
+func BenchmarkScanner(b *testing.B) {
+	// ARRANGE.
+	var s strings.Builder
+
+	for i := 0; i < 1_000; i++ {
+		s.WriteString("HelloWorld99HTML")
+	}
+
+	input := s.String()
+
+	// RESET.
+	b.ResetTimer()
+
+	// EXECUTION.
+	for i := 0; i < b.N; i++ {
+		scanner := camelcase.NewScanner(strings.NewReader(input))
+
+		for scanner.Scan() {
+			_ = scanner.Bytes()
+		}
+	}
+}
@@ -0,0 +1,95 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// Returns the words of t into which v can be segmented (using as few words as possible), or nil if v can't be
+// segmented into words of t.
+// When multiple segmentations have the same number of words, the one with the longest first word(s) is returned.
+func (t *trie) segment(v string) []string {
+	count := make([]int, len(v)+1) // The minimum number of words into which v[idx:] can be segmented (or -1).
+	next := make([]int, len(v)+1)  // The position (in v) where the first word of that segmentation ends.
+
+	for sIdx := len(v) - 1; sIdx >= 0; sIdx-- {
+		count[sIdx] = -1
+
+		if !utf8.RuneStart(v[sIdx]) {
+			continue
+		}
+
+		node := t
+
+		for idx, r := range v[sIdx:] {
+			if node = node.next(r); node == nil {
+				break
+			}
+
+			// NOTE: A longer word replaces a shorter one that results in the same number of words.
+			if eIdx := sIdx + idx + utf8.RuneLen(r); node.terminal && count[eIdx] >= 0 &&
+				(count[sIdx] < 0 || count[eIdx]+1 <= count[sIdx]) {
+				count[sIdx], next[sIdx] = count[eIdx]+1, eIdx
+			}
+		}
+	}
+
+	if count[0] < 0 {
+		return nil
+	}
+
+	retVal := make([]string, 0, count[0])
+
+	for sIdx := 0; sIdx < len(v); sIdx = next[sIdx] {
+		retVal = append(retVal, v[sIdx:next[sIdx]])
+	}
+
+	return retVal
+}
+
+// Checks whether or not v consists of lowercase letters only.
+func isLowerWord(v string) bool {
+	for _, r := range v {
+		if !isLower(r) {
+			return false
+		}
+	}
+
+	return len(v) > 0
+}
+
+// Checks whether or not v consists of uppercase letters only.
+func isUpperWord(v string) bool {
+	for _, r := range v {
+		if !unicode.IsUpper(r) {
+			return false
+		}
+	}
+
+	return len(v) > 0
+}
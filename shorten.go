@@ -0,0 +1,180 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Abbrev returns the initials of the words of v, converted to uppercase, so "BackgroundTaskManager" becomes "BTM".
+// The words are determined by splitting v on delimiters and on "CamelCase" boundaries. Numbers are kept whole, so
+// "GL11Version" becomes "G11V".
+// If v isn't a valid UTF-8 string, v is returned unmodified.
+func Abbrev(v string) string {
+	return abbrev(v, false)
+}
+
+// AbbrevKeepAcronyms returns the initials of the words of v, like Abbrev, but keeps acronyms whole, so
+// "HTTPServerPool" becomes "HTTPSP" instead of "HSP".
+// If v isn't a valid UTF-8 string, v is returned unmodified.
+func AbbrevKeepAcronyms(v string) string {
+	return abbrev(v, true)
+}
+
+// Returns the initials of the words of v, converted to uppercase.
+// If keepAcronyms is true, acronyms are kept whole.
+func abbrev(v string, keepAcronyms bool) string {
+	if !utf8.ValidString(v) {
+		return v
+	}
+
+	var sb strings.Builder
+
+	for _, w := range splitWords(v, nil) {
+		r, size := utf8.DecodeRuneInString(w)
+
+		switch {
+		case isDigit(r), keepAcronyms && isAcronym(w):
+			sb.WriteString(w)
+		default:
+			sb.WriteString(strings.ToUpper(w[:size]))
+		}
+	}
+
+	return sb.String()
+}
+
+// Shorten abbreviates the words of v, one word at a time (from the first to the last word), until v is no longer than
+// maxLen bytes.
+// Each word is abbreviated by looking it up (ignoring case) in abbrevs, such as "Manager" → "Mgr", and the abbreviation
+// takes over the case of the word, so "AccountManager" becomes "AccountMgr" and "ACCOUNT_MANAGER" becomes
+// "ACCOUNT_MGR". The delimiters in v are kept as-is.
+// If v is still longer than maxLen bytes after abbreviating each word that's found in abbrevs, the abbreviated string
+// is returned (see Truncate to shorten it any further).
+// If v isn't a valid UTF-8 string, v is returned unmodified.
+func Shorten(v string, maxLen int, abbrevs map[string]string) string {
+	if !utf8.ValidString(v) || len(v) <= maxLen {
+		return v
+	}
+
+	lookup := make(map[string]string, len(abbrevs))
+
+	for w, abbr := range abbrevs {
+		lookup[strings.ToLower(w)] = abbr
+	}
+
+	var sb strings.Builder
+
+	n, last := len(v), 0
+
+	for _, idx := range wordIndexes(v) {
+		w := v[idx[0]:idx[1]]
+		abbr, ok := lookup[strings.ToLower(w)]
+
+		if n <= maxLen || !ok {
+			continue
+		}
+
+		abbr = matchCase(abbr, w)
+		n = n - len(w) + len(abbr)
+
+		sb.WriteString(v[last:idx[0]])
+		sb.WriteString(abbr)
+		last = idx[1]
+	}
+
+	sb.WriteString(v[last:])
+
+	return sb.String()
+}
+
+// Truncate cuts v at a word boundary, so that v (including suffix) is no longer than maxLen runes, and appends suffix
+// (such as "…") when v is cut, so "CustomerRelationshipManager" becomes "Customer…" when maxLen is 20.
+// The words are determined by splitting v on delimiters and on "CamelCase" boundaries, and v is never cut in the
+// middle of a word: if even the first word doesn't fit, only suffix is returned, so "CustomerRelationshipManager"
+// becomes "…" when maxLen is 3. If suffix itself doesn't fit, v is cut without appending suffix (which returns an
+// empty string when the first word doesn't fit either).
+// Unlike Shorten, maxLen is expressed in runes, since Truncate is meant for display purposes.
+// If v isn't a valid UTF-8 string, v is returned unmodified.
+func Truncate(v string, maxLen int, suffix string) string {
+	if !utf8.ValidString(v) || utf8.RuneCountInString(v) <= maxLen {
+		return v
+	}
+
+	budget := maxLen - utf8.RuneCountInString(suffix)
+
+	if budget < 0 {
+		budget, suffix = maxLen, ""
+	}
+
+	eIdx := 0
+
+	for _, idx := range wordIndexes(v) {
+		if utf8.RuneCountInString(v[:idx[1]]) > budget {
+			break
+		}
+
+		eIdx = idx[1]
+	}
+
+	return v[:eIdx] + suffix
+}
+
+// Returns the start and end offsets (in bytes) of the words of v, determined by splitting v on delimiters and on
+// "CamelCase" boundaries.
+func wordIndexes(v string) [][2]int {
+	retVal := make([][2]int, 0)
+	pos := 0
+
+	// NOTE: Each word is a substring of v, and the words are only separated by delimiters, so the first occurrence of a
+	// word (after the previous word) is the word itself.
+	for _, w := range splitWords(v, nil) {
+		sIdx := pos + strings.Index(v[pos:], w)
+		pos = sIdx + len(w)
+		retVal = append(retVal, [2]int{sIdx, pos})
+	}
+
+	return retVal
+}
+
+// Returns v converted to the case of w.
+// If w is an acronym, v is converted to uppercase. If w starts with an uppercase rune, v is converted to lowercase
+// starting with an uppercase rune. Otherwise, v is converted to lowercase.
+func matchCase(v, w string) string {
+	if isAcronym(w) {
+		return strings.ToUpper(v)
+	}
+
+	v = strings.ToLower(v)
+
+	if r, _ := utf8.DecodeRuneInString(w); unicode.IsUpper(r) || unicode.IsTitle(r) {
+		return caseMapping{}.join([]string{v}, "", upperFirstCase, upperFirstCase)
+	}
+
+	return v
+}
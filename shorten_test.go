@@ -0,0 +1,133 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Abbreviate a string to the initials of its words.
+func TestAbbrev(t *testing.T) {
+	for _, tc := range []struct {
+		vInput   string
+		want     string
+		wantKeep string
+	}{
+		{vInput: "", want: "", wantKeep: ""},
+		{vInput: "BackgroundTaskManager", want: "BTM", wantKeep: "BTM"},
+		{vInput: "HTTPServerPool", want: "HSP", wantKeep: "HTTPSP"},
+		{vInput: "user_account_id", want: "UAI", wantKeep: "UAI"},
+		{vInput: "GL11Version", want: "G11V", wantKeep: "GL11V"},
+		{vInput: "überWichtigeSache", want: "ÜWS", wantKeep: "ÜWS"},
+		{vInput: "BadUTF8\xe2\xe2\xa1", want: "BadUTF8\xe2\xe2\xa1", wantKeep: "BadUTF8\xe2\xe2\xa1"},
+	} {
+		// ACT.
+		got := camelcase.Abbrev(tc.vInput)
+		gotKeep := camelcase.AbbrevKeepAcronyms(tc.vInput)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Abbreviate a string to the initials of its words.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+
+		assert.Equal(t, gotKeep, tc.wantKeep, "", "\n\n"+
+			"UT Name:  Abbreviate a string to the initials of its words, keeping acronyms whole.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.wantKeep, gotKeep)
+	}
+}
+
+// UT: Shorten a string by abbreviating its words.
+func TestShorten(t *testing.T) {
+	// ARRANGE.
+	abbrevs := map[string]string{"manager": "Mgr", "Number": "num", "account": "Acct", "configuration": "Cfg"}
+
+	for _, tc := range []struct {
+		vInput  string
+		vMaxLen int
+		want    string
+	}{
+		{vInput: "AccountManager", vMaxLen: 20, want: "AccountManager"},
+		{vInput: "AccountManager", vMaxLen: 11, want: "AcctManager"},
+		{vInput: "AccountManager", vMaxLen: 7, want: "AcctMgr"},
+		{vInput: "AccountManagerNumber", vMaxLen: 16, want: "AcctMgrNumber"},
+		{vInput: "AccountManagerNumber", vMaxLen: 5, want: "AcctMgrNum"},
+		{vInput: "account_manager_number", vMaxLen: 18, want: "acct_mgr_number"},
+		{vInput: "ACCOUNT_MANAGER", vMaxLen: 10, want: "ACCT_MGR"},
+		{vInput: "  app.configuration-manager ", vMaxLen: 20, want: "  app.cfg-manager "},
+		{vInput: "BadUTF8\xe2\xe2\xa1", vMaxLen: 1, want: "BadUTF8\xe2\xe2\xa1"},
+	} {
+		// ACT.
+		got := camelcase.Shorten(tc.vInput, tc.vMaxLen, abbrevs)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Shorten a string by abbreviating its words.\n"+
+			"Input:    %q (max. length: %d)\n"+
+			"\033[32mExpected: %q\033[0m\n"+
+			"\033[31mActual:   %q\033[0m\n\n", tc.vInput, tc.vMaxLen, tc.want, got)
+	}
+}
+
+// UT: Truncate a string at a word boundary.
+func TestTruncate(t *testing.T) {
+	for _, tc := range []struct {
+		vInput  string
+		vMaxLen int
+		vSuffix string
+		want    string
+	}{
+		{vInput: "CustomerRelationshipManager", vMaxLen: 30, vSuffix: "…", want: "CustomerRelationshipManager"},
+		{vInput: "CustomerRelationshipManager", vMaxLen: 27, vSuffix: "…", want: "CustomerRelationshipManager"},
+		{vInput: "CustomerRelationshipManager", vMaxLen: 26, vSuffix: "…", want: "CustomerRelationship…"},
+		{vInput: "CustomerRelationshipManager", vMaxLen: 20, vSuffix: "…", want: "Customer…"},
+		{vInput: "CustomerRelationshipManager", vMaxLen: 20, vSuffix: "", want: "CustomerRelationship"},
+		{vInput: "CustomerRelationshipManager", vMaxLen: 6, vSuffix: "...", want: "..."},
+		{vInput: "CustomerRelationshipManager", vMaxLen: 3, vSuffix: "…", want: "…"},
+		{vInput: "CustomerRelationshipManager", vMaxLen: 2, vSuffix: "...", want: ""},
+		{vInput: "Customer_RelationshipManager", vMaxLen: 12, vSuffix: "…", want: "Customer…"},
+		{vInput: "customer_relationship_manager", vMaxLen: 24, vSuffix: "…", want: "customer_relationship…"},
+		{vInput: "ÜberWichtigeSache", vMaxLen: 13, vSuffix: "…", want: "ÜberWichtige…"},
+		{vInput: "BadUTF8\xe2\xe2\xa1", vMaxLen: 2, vSuffix: "…", want: "BadUTF8\xe2\xe2\xa1"},
+	} {
+		// ACT.
+		got := camelcase.Truncate(tc.vInput, tc.vMaxLen, tc.vSuffix)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Truncate a string at a word boundary.\n"+
+			"Input:    %q (max. length: %d, suffix: %q)\n"+
+			"\033[32mExpected: %q\033[0m\n"+
+			"\033[31mActual:   %q\033[0m\n\n", tc.vInput, tc.vMaxLen, tc.vSuffix, tc.want, got)
+	}
+}
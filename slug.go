@@ -0,0 +1,55 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// Slug converts v (such as a title or the name of a type) into a URL-safe slug, such as "html-parser-for-creme".
+// The words are determined by splitting v on delimiters and on "CamelCase" boundaries, transliterated to ASCII (so "é"
+// becomes "e" and "ß" becomes "ss"), converted to lowercase and joined using dashes.
+// Apostrophes are removed, and each other rune that isn't an ASCII letter or an ASCII digit (after transliteration) is
+// treated as a delimiter, so "Don't Panic!" becomes "dont-panic".
+// When v doesn't contain any letter or digit that can be written in ASCII (such as "日本語" or "Привет мир"), the
+// letters and digits of v are kept as-is instead, so the slug isn't empty: "Привет мир" becomes "привет-мир". Such a
+// slug should be percent-encoded (such as using url.PathEscape) where only ASCII is allowed.
+// An empty string is only returned when v doesn't contain any letter or digit.
+func Slug(v string) string {
+	words := sanitizedWords(v, isASCIIAlphanumeric)
+
+	if len(words) == 0 {
+		words = sanitizedWords(v, func(r rune) bool { return unicode.IsLetter(r) || isDigit(r) })
+	}
+
+	return Format(words, Kebab)
+}
+
+// Checks whether or not r is an ASCII letter or an ASCII digit.
+func isASCIIAlphanumeric(r rune) bool {
+	return r < utf8.RuneSelf && asciiClass[r] != 0
+}
@@ -0,0 +1,86 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Convert a string into a URL-safe slug.
+func TestSlug(t *testing.T) {
+	for _, tc := range []struct {
+		vInput string
+		want   string
+	}{
+		{vInput: "", want: ""},
+		{vInput: "?!", want: ""},
+		{vInput: "HelloWorld", want: "hello-world"},
+		{vInput: "HTMLParser", want: "html-parser"},
+		{vInput: "user_id", want: "user-id"},
+		{vInput: "  Hello,   World!  ", want: "hello-world"},
+		{vInput: "Don't Panic!", want: "dont-panic"},
+		{vInput: "Crème Brûlée", want: "creme-brulee"},
+		{vInput: "Straße", want: "strasse"},
+		{vInput: "ÉcoleNormale", want: "ecole-normale"},
+		{vInput: "Top10Movies", want: "top-10-movies"},
+		{vInput: "Δx and ÆON", want: "x-and-aeon"},
+		{vInput: "café\xffbar", want: "cafe-bar"},
+		{vInput: "Æsir Þórr", want: "aesir-thorr"},
+		{vInput: "ÆsirÞórr", want: "aesir-thorr"},
+		{vInput: "ŒuvreComplète", want: "oeuvre-complete"},
+		{vInput: "ÆON", want: "aeon"},
+		{vInput: "GroßeStraße", want: "grosse-strasse"},
+		{vInput: "日本語", want: "日本語"},
+		{vInput: "Привет, мир!", want: "привет-мир"},
+		{vInput: "Привет World", want: "world"},
+		{vInput: "— · —", want: ""},
+	} {
+		// ACT.
+		got := camelcase.Slug(tc.vInput)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Convert a string into a URL-safe slug.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// Benchmark: Convert a string into a URL-safe slug.
+func BenchmarkSlug(b *testing.B) {
+	// RESET.
+	b.ResetTimer()
+
+	// EXECUTION.
+	for i := 0; i < b.N; i++ {
+		_ = camelcase.Slug("ÉcoleNormale: Crème Brûlée")
+	}
+}
@@ -0,0 +1,57 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+//go:build camelcase_model
+
+package camelcase
+
+import (
+	_ "embed"
+	"strings"
+	"sync"
+)
+
+// The words used by SplitSmart, ordered from the most to the least frequently used word.
+//
+//go:embed wordfreq.txt
+var wordFreq string
+
+// The Splitter used by SplitSmart, which is created when SplitSmart is called for the first time.
+var smartSplitter = sync.OnceValue(func() *Splitter {
+	// NOTE: Reading from a strings.Reader never fails.
+	m, _ := ReadModel(strings.NewReader(wordFreq))
+
+	return New(WithModel(m))
+})
+
+// SplitSmart splits v into words (on delimiters and on "CamelCase" boundaries), after which each word consisting of
+// lowercase letters only is segmented into its most likely words, such as "parse", "html", "file" for
+// "parsehtmlfile".
+// The word frequencies are counted in English prose, such as manual pages and documentation (see wordfreq.txt).
+// SplitSmart is only available when building with the "camelcase_model" build tag, which embeds the word frequencies
+// into the binary. Use New and WithModel to segment words using other word frequencies.
+func SplitSmart(v string) []string {
+	return smartSplitter().Split(v)
+}
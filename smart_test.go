@@ -0,0 +1,75 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+//go:build camelcase_model
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Split a string into its most likely words.
+func TestSplitSmart(t *testing.T) {
+	for _, tc := range []struct {
+		vInput string
+		want   []string
+	}{
+		{vInput: "", want: []string{""}},
+		{vInput: "parsehtmlfile", want: []string{"parse", "html", "file"}},
+		{vInput: "readtimeoutexceeded", want: []string{"read", "timeout", "exceeded"}},
+		{vInput: "max_buffersize", want: []string{"max", "buffer", "size"}},
+		{vInput: "expertsexchange", want: []string{"experts", "exchange"}},
+		{vInput: "newhttprequesthandler", want: []string{"new", "http", "request", "handler"}},
+	} {
+		// ACT.
+		got := camelcase.SplitSmart(tc.vInput)
+
+		// ASSERT.
+		assert.EqualS(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Split a string into its most likely words.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// Benchmark: Split a string into its most likely words.
+func BenchmarkSplitSmart(b *testing.B) {
+	// ARRANGE.
+	input := "parsehtmlfilereadtimeoutexceeded"
+
+	// RESET.
+	b.ResetTimer()
+
+	// EXECUTION.
+	for i := 0; i < b.N; i++ {
+		_ = camelcase.SplitSmart(input)
+	}
+}
@@ -0,0 +1,988 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import (
+	"iter"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/kdeconinck/slices"
+	"golang.org/x/text/unicode/norm"
+)
+
+// NumberMode defines how a Splitter treats numbers.
+type NumberMode int
+
+// The supported number modes.
+// By default, numbers are separate words. They can also be attached to the previous word or to the next word.
+const (
+	NumberSeparate       NumberMode = iota // "GL11Version" → "GL", "11", "Version".
+	NumberAttachPrevious                   // "GL11Version" → "GL11", "Version".
+	NumberAttachNext                       // "5May2000" → "5May", "2000".
+)
+
+// SingleLetterMode defines how a Splitter treats a single uppercase letter that's followed by a capitalized word.
+type SingleLetterMode int
+
+// The supported single letter modes.
+// By default, a single uppercase letter is a separate word. It can also be kept with the next word, or merged into the
+// next word, which is converted to lowercase, so it reads as a single capitalized word.
+const (
+	SingleLetterSplit SingleLetterMode = iota // "ASetOfColors" → "A", "Set", "Of", "Colors".
+	SingleLetterKeep                          // "ASetOfColors" → "ASet", "Of", "Colors".
+	SingleLetterMerge                         // "ASetOfColors" → "Aset", "Of", "Colors".
+)
+
+// AcronymCase defines how a Splitter writes the acronyms when formatting words.
+type AcronymCase int
+
+// The supported acronym cases.
+// By default, an acronym is written as it's configured (see WithAcronyms). It can also be written as a capitalized
+// word or as a lowercase word, after which the naming convention decides whether or not its first rune is uppercase.
+const (
+	AcronymKeep  AcronymCase = iota // "user_id" → "UserID" (Pascal), "userID" (Camel), "User ID" (Humanize).
+	AcronymTitle                    // "user_id" → "UserId" (Pascal), "userId" (Camel), "User Id" (Humanize).
+	AcronymLower                    // "user_id" → "UserId" (Pascal), "userId" (Camel), "User id" (Humanize).
+)
+
+// InvalidUTF8Mode defines how a Splitter treats strings that aren't valid UTF-8 strings.
+type InvalidUTF8Mode int
+
+// The supported modes for treating strings that aren't valid UTF-8 strings.
+const (
+	InvalidUTF8Keep    InvalidUTF8Mode = iota // The string is returned as-is, without splitting it.
+	InvalidUTF8Replace                        // Each sequence of invalid bytes is replaced by U+FFFD.
+	InvalidUTF8Drop                           // Each sequence of invalid bytes is removed.
+)
+
+// A Splitter splits strings into words using a configurable set of rules.
+// A Splitter should be created using New and is safe for concurrent use.
+type Splitter struct {
+	noSplit     []string              // The words that shouldn't be split.
+	wholeWords  []string              // The words that shouldn't be split, but only when found as a whole word.
+	wholeOnly   bool                  // A flag indicating if all the words in noSplit are treated as whole words.
+	foldCase    bool                  // A flag indicating if noSplit and wholeWords are matched case-insensitively.
+	noSplitT    *trie                 // The words that shouldn't be split (compiled into a trie).
+	wholeWordsT *trie                 // The words that shouldn't be split, but only as a whole word (compiled).
+	acronyms    []string              // The acronyms, used when formatting words.
+	acronymCase AcronymCase           // The way acronyms are written when formatting words.
+	strcase     bool                  // A flag indicating if Convert reproduces github.com/iancoleman/strcase.
+	fatih       bool                  // A flag indicating if strings are split like github.com/fatih/camelcase.
+	escape      func(string) string   // Escapes the result of Convert when it's a Go keyword (if configured).
+	inflect     func(string) string   // Inflects the final word in the result of Convert (if configured).
+	cm          caseMapping           // The case mappings, used when formatting words.
+	normalize   bool                  // A flag indicating if the input is normalized before it's split.
+	form        norm.Form             // The Unicode normalization form, used when normalize is true.
+	scripts     bool                  // A flag indicating if a word boundary is inserted when the script changes.
+	invalidUTF8 InvalidUTF8Mode       // The way strings that aren't valid UTF-8 strings are treated.
+	numberMode  NumberMode            // The way numbers are treated.
+	letterMode  SingleLetterMode      // The way single uppercase letters followed by a capitalized word are treated.
+	literals    bool                  // A flag indicating if hexadecimal, binary and octal literals are single numbers.
+	versions    bool                  // A flag indicating if version tokens (such as "V1Beta1") are single words.
+	units       []string              // The unit (and currency) suffixes that are kept with their number.
+	unitsT      *trie                 // The unit (and currency) suffixes (compiled into a trie).
+	isDelimiter func(rune) bool       // Reports whether or not a rune separates words.
+	classifier  Classifier            // The classifier used to classify the runes (nil for the default one).
+	delimTokens bool                  // A flag indicating if each sequence of delimiters is returned as a token.
+	apostrophes bool                  // A flag indicating if apostrophes between letters are part of a word.
+	hyphens     bool                  // A flag indicating if hyphens between letters are part of a word.
+	underscores bool                  // A flag indicating if leading and trailing underscores are kept.
+	sigils      []rune                // The sigils that are returned as a separate token when they start the input.
+	hungarian   []string              // The Hungarian prefixes that are stripped from the start of the input.
+	dictionary  []string              // The words into which lowercase words are segmented.
+	dictionaryT *trie                 // The words into which lowercase words are segmented (compiled into a trie).
+	model       *Model                // The word frequencies used to segment lowercase words (if any).
+	segmentCaps bool                  // A flag indicating if uppercase words are segmented too.
+	segment     func(string) []string // Segments a lowercase word into words (if configured).
+	stopWords   []string              // The words that are dropped from the result.
+	transforms  []func(string) string // The functions that are applied to each word (in order).
+	cloneWords  bool                  // A flag indicating if the words don't share memory with the input.
+	cacheSize   int                   // The maximum number of strings for which the words are cached.
+	cache       *lruCache             // The cache holding the words of the most recently split strings (if any).
+}
+
+// An Option configures a Splitter.
+type Option func(*Splitter)
+
+// New returns a Splitter configured using opts.
+// Unless configured otherwise, the returned Splitter splits on underscores, dashes, dots, slashes and whitespace
+// characters (which are dropped) and on "CamelCase" boundaries, and treats numbers as separate words.
+func New(opts ...Option) *Splitter {
+	s := &Splitter{isDelimiter: isDelimiter}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.compile()
+
+	return s
+}
+
+// Returns a Splitter that splits on the default delimiters and on "CamelCase" boundaries.
+// Each word in noSplit is treated as a word that shouldn't be split. Each word in acronyms is treated as an acronym.
+func newDefaultSplitter(noSplit, acronyms []string) *Splitter {
+	s := &Splitter{noSplit: noSplit, acronyms: acronyms, isDelimiter: isDelimiter}
+
+	s.compile()
+
+	return s
+}
+
+// Compile the words that shouldn't be split into tries, so they can be matched efficiently.
+func (s *Splitter) compile() {
+	if s.wholeOnly {
+		s.wholeWords = append(s.wholeWords, s.noSplit...)
+		s.noSplit = nil
+	}
+
+	s.noSplitT = newTrie(s.noSplit, s.foldCase)
+	s.wholeWordsT = newTrie(s.wholeWords, s.foldCase)
+	s.dictionaryT = newTrie(s.dictionary, true)
+	s.unitsT = newTrie(s.units, true)
+
+	switch {
+	case s.model != nil:
+		s.segment = s.model.segment
+	case s.dictionaryT != nil:
+		s.segment = s.dictionaryT.segment
+	}
+
+	if s.cacheSize > 0 {
+		s.cache = newLRUCache(s.cacheSize)
+	}
+}
+
+// WithNoSplit returns an Option that treats each word in words as a word that shouldn't be split.
+func WithNoSplit(words ...string) Option {
+	return func(s *Splitter) {
+		s.noSplit = append(s.noSplit, words...)
+	}
+}
+
+// WithWholeWords returns an Option that treats each word in words as a word that shouldn't be split, but only when
+// it's found as a whole word.
+// In contrast to WithNoSplit, a word is only kept intact when it's found completely and when it's followed by a word
+// boundary, so "HttpComm" is split into "Http" and "Comm", even though "HttpCommunication" is a whole word.
+func WithWholeWords(words ...string) Option {
+	return func(s *Splitter) {
+		s.wholeWords = append(s.wholeWords, words...)
+	}
+}
+
+// WithWholeWordMatching returns an Option that treats all the words that shouldn't be split (including acronyms) as
+// whole words (see WithWholeWords).
+func WithWholeWordMatching() Option {
+	return func(s *Splitter) {
+		s.wholeOnly = true
+	}
+}
+
+// WithCaseInsensitiveNoSplit returns an Option that matches the words that shouldn't be split case-insensitively, so
+// "tls2", "Tls2" and "TLS2" are all kept intact when "Tls2" is a word that shouldn't be split.
+func WithCaseInsensitiveNoSplit() Option {
+	return func(s *Splitter) {
+		s.foldCase = true
+	}
+}
+
+// WithAcronyms returns an Option that treats each word in acronyms as a word that shouldn't be split.
+// When formatting, each word that equals (ignoring case) an acronym is replaced by that acronym.
+func WithAcronyms(acronyms ...string) Option {
+	return func(s *Splitter) {
+		s.noSplit = append(s.noSplit, acronyms...)
+		s.acronyms = append(s.acronyms, acronyms...)
+	}
+}
+
+// WithAcronymCase returns an Option that writes the acronyms according to c when formatting words, so that the same
+// acronyms can be used for Go ("UserID"), C# ("UserId") and JSON ("userId").
+// It applies to the acronyms configured using WithAcronyms, and (in Humanize) to the words that are acronyms, in the
+// result of Parse, Convert and Humanize. ToPascal and ToLowerCamel are equivalent to a Splitter configured using
+// WithAcronyms, so New(WithAcronyms("ID"), WithAcronymCase(AcronymTitle)).Convert("user_id", Pascal) returns "UserId".
+func WithAcronymCase(c AcronymCase) Option {
+	return func(s *Splitter) {
+		s.acronymCase = c
+	}
+}
+
+// WithStrcaseCompat returns an Option that makes Convert reproduce the output of github.com/iancoleman/strcase
+// (v0.3.0), so that code migrating from that package keeps generating the same names, including for edge cases such as
+// digits ("numbers2and55" → "numbers_2_and_55") and consecutive uppercase letters ("ID" → "Id" using Pascal).
+// It applies to Camel (ToLowerCamel), Pascal (ToCamel), Snake (ToSnake), ScreamingSnake (ToScreamingSnake), Kebab
+// (ToKebab) and Dot (ToDelimited with a dot). Other naming conventions, as well as Split, SplitInto, SplitSeq and
+// Parse, aren't affected. The input is only repaired (see WithInvalidUTF8), all other options are ignored by Convert.
+// NOTE: Like github.com/iancoleman/strcase, only ASCII letters are converted, and Camel and Pascal drop each byte that
+// isn't an ASCII letter, digit or delimiter, so "über_cool" becomes "berCool" using Camel.
+func WithStrcaseCompat() Option {
+	return func(s *Splitter) {
+		s.strcase = true
+	}
+}
+
+// WithFatihCompat returns an Option that splits strings the way github.com/fatih/camelcase's Split does, so that code
+// migrating from that package gets the same words.
+// The string is divided into sequences of lowercase letters, uppercase letters, digits and other runes, and the last
+// rune of a sequence of uppercase letters is moved to the sequence of lowercase letters that follows it. Delimiters
+// aren't dropped, so "Two  spaces" is split into "Two", "  " and "spaces", and an empty string has no words.
+// The other options that determine how a string is split (such as WithNoSplit, WithDelimiters or WithNumberMode) are
+// ignored, but the words are still dropped, transformed and copied (see WithStopWords, WithWordTransform and
+// WithClonedWords).
+func WithFatihCompat() Option {
+	return func(s *Splitter) {
+		s.fatih = true
+	}
+}
+
+// WithSpecialCase returns an Option that uses the language specific case mappings in c when formatting words, such as
+// unicode.TurkishCase, which maps "I" to "ı" and "i" to "İ".
+func WithSpecialCase(c unicode.SpecialCase) Option {
+	return func(s *Splitter) {
+		s.cm = caseMapping{special: c}
+	}
+}
+
+// WithNormalization returns an Option that normalizes the input to the Unicode normalization form f (such as norm.NFC
+// or norm.NFKC) before it's split, so visually identical strings produce identical words.
+// Note that the words are taken from the normalized input, so they might differ from the corresponding parts of the
+// input.
+func WithNormalization(f norm.Form) Option {
+	return func(s *Splitter) {
+		s.normalize = true
+		s.form = f
+	}
+}
+
+// WithScriptBoundaries returns an Option that inserts a word boundary when the script (such as Latin, Cyrillic or Han)
+// changes, so "UserID用户名Test" is split into "User", "ID", "用户名" and "Test".
+func WithScriptBoundaries() Option {
+	return func(s *Splitter) {
+		s.scripts = true
+	}
+}
+
+// WithInvalidUTF8 returns an Option that treats strings that aren't valid UTF-8 strings according to mode.
+// By default, such strings aren't split at all. Using InvalidUTF8Replace or InvalidUTF8Drop, the invalid bytes are
+// replaced (or removed) and the remainder of the string is split as usual.
+func WithInvalidUTF8(mode InvalidUTF8Mode) Option {
+	return func(s *Splitter) {
+		s.invalidUTF8 = mode
+	}
+}
+
+// WithNumberMode returns an Option that treats numbers according to mode.
+func WithNumberMode(mode NumberMode) Option {
+	return func(s *Splitter) {
+		s.numberMode = mode
+	}
+}
+
+// WithSingleLetterMode returns an Option that treats a single uppercase letter that's followed by a capitalized word
+// (such as the "A" in "ASet" or in "getAColor") according to mode.
+func WithSingleLetterMode(mode SingleLetterMode) Option {
+	return func(s *Splitter) {
+		s.letterMode = mode
+	}
+}
+
+// WithNumberLiterals returns an Option that treats hexadecimal ("0xFF"), binary ("0b1010") and octal ("0o755")
+// literals as single numbers, so "Mask0xFFVariant" is split into "Mask", "0xFF" and "Variant".
+// The digits of a literal are read greedily, so "0xCafeBabe" is a single number too.
+func WithNumberLiterals() Option {
+	return func(s *Splitter) {
+		s.literals = true
+	}
+}
+
+// WithVersionTokens returns an Option that treats version tokens, as used in the names of Kubernetes API types (such as
+// "V2", "V1Beta1" or "v2alpha3"), as single words, so "DeploymentV1Beta1Spec" is split into "Deployment", "V1Beta1"
+// and "Spec".
+// A version token is a "V" (or "v"), followed by a number and an optional stability level ("Alpha" or "Beta")
+// with its own number. It's only recognized at the start of a word, and when it's followed by a word boundary.
+func WithVersionTokens() Option {
+	return func(s *Splitter) {
+		s.versions = true
+	}
+}
+
+// WithDelimiters returns an Option that splits on each rune in delimiters (the delimiters are dropped).
+// It replaces the default delimiters, so WithDelimiters() (without any delimiters) only splits on "CamelCase"
+// boundaries.
+func WithDelimiters(delimiters ...rune) Option {
+	delimiters = append([]rune(nil), delimiters...)
+
+	return func(s *Splitter) {
+		s.isDelimiter = func(r rune) bool {
+			return slices.Contains(delimiters, r)
+		}
+	}
+}
+
+// WithClassifier returns an Option that classifies runes using c, instead of using their Unicode properties, so that
+// domains with unusual alphabets can decide which runes are uppercase, lowercase, digits or delimiters.
+// It replaces the delimiters (see WithDelimiters) by the ones of c.
+// NOTE: The options that recognize specific patterns (such as WithNumberLiterals, WithVersionTokens or
+// WithApostrophes) still classify the runes of those patterns using their Unicode properties.
+func WithClassifier(c Classifier) Option {
+	return func(s *Splitter) {
+		s.classifier = c
+		s.isDelimiter = c.IsDelimiter
+	}
+}
+
+// WithDictionary returns an Option that segments each word consisting of lowercase letters only into words of words,
+// so that a flat identifier such as "parsehtmlfile" is split into "parse", "html" and "file".
+// The words are matched case-insensitively, and a segmentation with as few words as possible is used. A word that
+// can't be segmented completely into words of words is kept intact.
+func WithDictionary(words ...string) Option {
+	return func(s *Splitter) {
+		s.dictionary = append(s.dictionary, words...)
+	}
+}
+
+// WithModel returns an Option that segments each word consisting of lowercase letters only into its most likely
+// words according to m, so that an ambiguous identifier such as "expertsexchange" is split into "experts" and
+// "exchange". Runes that aren't part of a word in m are kept together as a single word.
+// When both a Model and a dictionary (see WithDictionary) are configured, the Model is used.
+func WithModel(m *Model) Option {
+	return func(s *Splitter) {
+		s.model = m
+	}
+}
+
+// WithUppercaseSegmentation returns an Option that also segments each word consisting of uppercase letters only (see
+// WithDictionary and WithModel), so that a constant such as "MYCONSTANTVALUE" is split into "MY", "CONSTANT" and
+// "VALUE". Since acronyms are uppercase words too, they should be part of the dictionary (or the Model) in order to be
+// kept intact.
+func WithUppercaseSegmentation() Option {
+	return func(s *Splitter) {
+		s.segmentCaps = true
+	}
+}
+
+// WithApostrophes returns an Option that treats an apostrophe ("'" or "’") between 2 letters as part of a word, so
+// "USER'S FILE" is split into "USER'S" and "FILE" (rather than "USER", "'S" and "FILE"). Any other apostrophe (such as
+// the ones in "'quoted'") is treated as a delimiter.
+// The apostrophes are kept when converting into Space or Title (so "can't open" becomes "Can't Open"), but they are
+// removed when converting into any other naming convention, since they aren't valid in identifiers.
+func WithApostrophes() Option {
+	return func(s *Splitter) {
+		s.apostrophes = true
+	}
+}
+
+// WithIntraWordHyphens returns an Option that treats a hyphen between 2 letters as part of a word, instead of as a
+// delimiter, so hyphenated compounds are kept intact: "X-RayScanner" is split into "X-Ray" and "Scanner".
+// Since "user-id" is a hyphenated compound too, it becomes a single word. Any other hyphen (such as the one in
+// "COVID-19") is still treated as a delimiter.
+func WithIntraWordHyphens() Option {
+	return func(s *Splitter) {
+		s.hyphens = true
+	}
+}
+
+// WithUnderscoreAffixes returns an Option that keeps the leading and trailing underscores of the input (such as the
+// ones in "_privateField" or "__init__"), instead of dropping them. The leading underscores are attached to the first
+// word and the trailing underscores are attached to the last word, so "_privateField" is split into "_private" and
+// "Field", and "__init__" is split into "__init__". An input that only consists of underscores is a single word.
+// Since the underscores are part of the words, Convert keeps them around the result: "_privateField" becomes
+// "_private_field" using Snake and "_PrivateField" using Pascal.
+func WithUnderscoreAffixes() Option {
+	return func(s *Splitter) {
+		s.underscores = true
+	}
+}
+
+// WithSigils returns an Option that returns a sigil (such as the "$" in "$httpClient" or the "@" in "@Injectable") at
+// the start of the input as a separate token, so "$httpClient" is split into "$", "http" and "Client".
+// The sigil is never dropped or transformed (see WithStopWords and WithWordTransform), and Convert keeps it in front of
+// the result, so "$httpClient" becomes "$http_client" using Snake.
+// If no sigils are provided, "$" and "@" are used.
+func WithSigils(sigils ...rune) Option {
+	if len(sigils) == 0 {
+		sigils = []rune{'$', '@'}
+	}
+
+	return func(s *Splitter) {
+		s.sigils = append(s.sigils, sigils...)
+	}
+}
+
+// WithDelimiterTokens returns an Option that returns each sequence of delimiters (with its original text) as a separate
+// token, instead of dropping it, so that the input can be reconstructed by concatenating the tokens, and tools can
+// rewrite some tokens while keeping the others intact. "user_id-FromHTTPHeader" is split into "user", "_", "id", "-",
+// "From", "HTTP" and "Header".
+// The delimiter tokens are never dropped or transformed (see WithStopWords and WithWordTransform), and Parse and
+// Convert treat them as words. When the input is normalized (see WithNormalization), the normalized input is the one
+// that can be reconstructed.
+func WithDelimiterTokens() Option {
+	return func(s *Splitter) {
+		s.delimTokens = true
+	}
+}
+
+// WithStopWords returns an Option that drops each word that equals (ignoring case) a word in words from the result,
+// such as boilerplate verbs ("Get", "Set") when indexing identifiers for search.
+// The words are dropped from the result of Split, SplitInto, SplitSeq, Parse and Convert.
+func WithStopWords(words ...string) Option {
+	return func(s *Splitter) {
+		s.stopWords = append(s.stopWords, words...)
+	}
+}
+
+// WithWordTransform returns an Option that replaces each word by the result of fn, such as a stemmed (or lowercase)
+// version of the word. Words for which fn returns an empty string are dropped.
+// When multiple transforms are configured, they are applied in the order in which they are configured.
+// The transforms are applied to the result of Split, SplitInto, SplitSeq, Parse and Convert, after dropping the stop
+// words (see WithStopWords).
+func WithWordTransform(fn func(word string) string) Option {
+	return func(s *Splitter) {
+		s.transforms = append(s.transforms, fn)
+	}
+}
+
+// WithClonedWords returns an Option that returns copies of the words, instead of strings that share their memory with
+// the input.
+// By default, the words are substrings of the input, which keeps the whole input in memory as long as any of its words
+// is in use. Copying the words allows a (large) input to be garbage collected when only a few words are kept.
+func WithClonedWords() Option {
+	return func(s *Splitter) {
+		s.cloneWords = true
+	}
+}
+
+// WithCache returns an Option that caches the words of the size most recently split strings, so that splitting the
+// same string again doesn't require it to be read again.
+// The cache is used by Split, SplitInto, Parse and Convert, and it's safe for concurrent use. Each call returns its own
+// copy of the cached words, so they can be modified by the caller.
+// If size is 0 (or negative), the words aren't cached.
+func WithCache(size int) Option {
+	return func(s *Splitter) {
+		s.cacheSize = size
+	}
+}
+
+// Split splits v into words.
+// If v isn't a valid UTF-8 string (and the Splitter isn't configured to repair it), or when v is an empty string
+// (unless the Splitter is configured using WithFatihCompat), a slice with one element (v) is returned.
+func (s *Splitter) Split(v string) []string {
+	v, ok := s.repair(v)
+
+	if !ok || (len(v) == 0 && !s.fatih) {
+		return []string{v}
+	}
+
+	return s.appendWords(make([]string, 0), v)
+}
+
+// SplitInto splits v into words, appends them to dst and returns the extended slice.
+// It produces the same words as Split, but allows the caller to reuse dst (e.g. SplitInto(dst[:0], v)), so that
+// splitting a large number of strings doesn't allocate a new slice for each of them.
+// If v isn't a valid UTF-8 string (and the Splitter isn't configured to repair it), or when v is an empty string
+// (unless the Splitter is configured using WithFatihCompat), v is appended as a single element.
+func (s *Splitter) SplitInto(dst []string, v string) []string {
+	v, ok := s.repair(v)
+
+	if !ok || (len(v) == 0 && !s.fatih) {
+		return append(dst, v)
+	}
+
+	return s.appendWords(dst, v)
+}
+
+// SplitSeq returns an iterator over the words of v.
+// It produces the same words as Split, but without allocating a slice to hold them, and stops reading v as soon as the
+// caller stops iterating.
+func (s *Splitter) SplitSeq(v string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		v, ok := s.repair(v)
+
+		if !ok || (len(v) == 0 && !s.fatih) {
+			yield(v)
+
+			return
+		}
+
+		s.eachWord(v, yield)
+	}
+}
+
+// Parse splits v into words so it can be formatted in different naming conventions without splitting v again.
+// When v consists of multiple words and doesn't contain any lowercase runes (such as "MY_CONSTANT_VALUE"), each word
+// is converted to lowercase. Each word that equals (ignoring case) an acronym is replaced by that acronym.
+// If v isn't a valid UTF-8 string (and the Splitter isn't configured to repair it), the returned Words holds one word
+// (v).
+func (s *Splitter) Parse(v string) Words {
+	v, ok := s.repair(v)
+
+	if !ok {
+		return Words{words: []string{v}, cm: s.cm}
+	}
+
+	return Words{words: s.formatWords(v), cm: s.cm}
+}
+
+// Convert splits v into words and formats them using the naming convention to.
+// When v consists of multiple words and doesn't contain any lowercase runes, the words are treated as lowercase words,
+// so "MY_CONSTANT_VALUE" is converted into "MyConstantValue" (rather than "MYCONSTANTVALUE") using Pascal.
+// If v isn't a valid UTF-8 string (and the Splitter isn't configured to repair it), v is returned unmodified.
+func (s *Splitter) Convert(v string, to Style) string {
+	v, ok := s.repair(v)
+
+	if !ok {
+		return v
+	}
+
+	if s.strcase {
+		if retVal, ok := strcaseConvert(v, to); ok {
+			return s.escapeReserved(retVal)
+		}
+	}
+
+	if sigil := s.sigilPrefix(v); len(sigil) > 0 {
+		return sigil + s.Convert(v[len(sigil):], to)
+	}
+
+	words := s.formatWords(v)
+
+	if s.apostrophes && to != Space && to != Title {
+		for idx, w := range words {
+			words[idx] = strings.Map(func(r rune) rune {
+				if isApostrophe(r) {
+					return -1
+				}
+
+				return r
+			}, w)
+		}
+	}
+
+	if s.inflect != nil && len(words) > 0 {
+		words[len(words)-1] = s.inflect(words[len(words)-1])
+	}
+
+	return s.escapeReserved(s.cm.format(words, to))
+}
+
+// Humanize splits v into words and converts them into a human readable string, suitable for display purposes.
+// Each word that isn't an acronym is converted to lowercase, the first word starts with an uppercase rune and the words
+// are joined using spaces. A word is an acronym when it equals (ignoring case) an acronym (see WithAcronyms) or when it
+// contains at least 2 letters and no lowercase letters, unless v consists of multiple words and doesn't contain any
+// lowercase runes. The acronyms are written according to the acronym case (see WithAcronymCase).
+// If v isn't a valid UTF-8 string (and the Splitter isn't configured to repair it), v is returned unmodified.
+func (s *Splitter) Humanize(v string) string {
+	v, ok := s.repair(v)
+
+	if !ok {
+		return v
+	}
+
+	return s.cm.join(s.humanWords(v), " ", upperFirstCase, keepCase)
+}
+
+// Returns the words of v, prepared for display purposes.
+// Each word that isn't an acronym is converted to lowercase, and each acronym is written according to the acronym
+// case.
+func (s *Splitter) humanWords(v string) []string {
+	words := s.cm.lowerAllCaps(s.appendWords(make([]string, 0), v), v)
+
+	for idx, w := range words {
+		acronym, ok := findAcronym(w, s.acronyms)
+
+		switch {
+		case ok:
+			words[idx] = s.writeAcronym(acronym)
+		case isAcronym(w):
+			words[idx] = s.writeAcronym(w)
+		default:
+			words[idx] = s.cm.toLower(w)
+		}
+	}
+
+	return words
+}
+
+// Returns the words of v, prepared for formatting.
+// When v consists of multiple words and doesn't contain any lowercase runes, each word is converted to lowercase,
+// after which each word that equals (ignoring case) an acronym is replaced by that acronym (written according to the
+// acronym case).
+func (s *Splitter) formatWords(v string) []string {
+	words := s.cm.lowerAllCaps(s.appendWords(make([]string, 0), v), v)
+
+	for idx, w := range words {
+		if acronym, ok := findAcronym(w, s.acronyms); ok {
+			words[idx] = s.writeAcronym(acronym)
+		}
+	}
+
+	return words
+}
+
+// Returns acronym, written according to the acronym case.
+func (s *Splitter) writeAcronym(acronym string) string {
+	switch s.acronymCase {
+	case AcronymTitle:
+		if r, size := utf8.DecodeRuneInString(acronym); size > 0 {
+			return string(s.cm.toTitleRune(r)) + s.cm.toLower(acronym[size:])
+		}
+	case AcronymLower:
+		return s.cm.toLower(acronym)
+	}
+
+	return acronym
+}
+
+// Returns v, repaired according to the way the Splitter handles invalid UTF-8, and a flag indicating if the returned
+// string is a valid UTF-8 string.
+func (s *Splitter) repair(v string) (string, bool) {
+	if utf8.ValidString(v) {
+		return v, true
+	}
+
+	switch s.invalidUTF8 {
+	case InvalidUTF8Replace:
+		return strings.ToValidUTF8(v, string(utf8.RuneError)), true
+	case InvalidUTF8Drop:
+		return strings.ToValidUTF8(v, ""), true
+	}
+
+	return v, false
+}
+
+// Split v on each delimiter and append the words of each part to dst.
+// If s has a cache, the words of v are taken from (or added to) that cache.
+func (s *Splitter) appendWords(dst []string, v string) []string {
+	if s.cache != nil {
+		if words, ok := s.cache.get(v); ok {
+			return append(dst, words...)
+		}
+	}
+
+	n := len(dst)
+
+	s.eachWord(v, func(w string) bool {
+		dst = append(dst, w)
+
+		return true
+	})
+
+	if s.cache != nil {
+		// NOTE: When the words are copied, the input isn't kept in memory by the cache either.
+		if s.cloneWords {
+			v = strings.Clone(v)
+		}
+
+		s.cache.add(v, append([]string(nil), dst[n:]...))
+	}
+
+	return dst
+}
+
+// Split v on each delimiter and call yield for each word of each part.
+// When yield returns false, no more words are produced and false is returned.
+// NOTE: Each word is segmented first, after which it's checked against the stop words, transformed and copied (in
+// that order).
+func (s *Splitter) eachWord(v string, yield func(string) bool) bool {
+	if sigil := s.sigilPrefix(v); len(sigil) > 0 {
+		if s.cloneWords {
+			sigil = strings.Clone(sigil)
+		}
+
+		return yield(sigil) && s.eachWord(v[len(sigil):], yield)
+	}
+
+	if s.underscores {
+		if prefix, suffix := underscoreAffixes(v); len(prefix)+len(suffix) > 0 {
+			return s.eachAffixedWord(prefix, v[len(prefix):len(v)-len(suffix)], suffix, yield)
+		}
+	}
+
+	if s.cloneWords {
+		yieldWord := yield
+
+		yield = func(w string) bool {
+			return yieldWord(strings.Clone(w))
+		}
+	}
+
+	// NOTE: Delimiters are never dropped, transformed or segmented, since they are only kept to reconstruct the input.
+	yieldDelimiters := func(string) bool { return true }
+
+	if s.delimTokens {
+		yieldDelimiters = yield
+	}
+
+	for idx := len(s.transforms) - 1; idx >= 0; idx-- {
+		yieldWord, fn := yield, s.transforms[idx]
+
+		yield = func(w string) bool {
+			if w = fn(w); len(w) == 0 {
+				return true
+			}
+
+			return yieldWord(w)
+		}
+	}
+
+	if len(s.stopWords) > 0 {
+		yieldWord := yield
+
+		yield = func(w string) bool {
+			return isListed(w, s.stopWords) || yieldWord(w)
+		}
+	}
+
+	if s.segment != nil {
+		yieldWord := yield
+
+		yield = func(w string) bool {
+			if !isLowerWord(w) && !(s.segmentCaps && isUpperWord(w)) {
+				return yieldWord(w)
+			}
+
+			words := s.segment(w)
+
+			if words == nil {
+				return yieldWord(w)
+			}
+
+			for _, w := range words {
+				if !yieldWord(w) {
+					return false
+				}
+			}
+
+			return true
+		}
+	}
+
+	if s.normalize {
+		v = s.form.String(v)
+	}
+
+	if len(s.hungarian) > 0 {
+		v = v[hungarianPrefixLen(v, s.hungarian):]
+	}
+
+	if s.fatih {
+		return eachFatihWord(v, yield)
+	}
+
+	sIdx, dIdx := -1, -1 // The position (in v) where the current part (or sequence of delimiters) starts.
+
+	for idx, r := range v {
+		if !s.isDelimiterAt(v, idx, r) {
+			if dIdx >= 0 && !yieldDelimiters(v[dIdx:idx]) {
+				return false
+			}
+
+			if sIdx < 0 {
+				sIdx = idx
+			}
+
+			dIdx = -1
+
+			continue
+		}
+
+		if sIdx >= 0 && !s.eachPartWord(v[sIdx:idx], yield) {
+			return false
+		}
+
+		if dIdx < 0 {
+			dIdx = idx
+		}
+
+		sIdx = -1
+	}
+
+	if dIdx >= 0 {
+		return yieldDelimiters(v[dIdx:])
+	}
+
+	return sIdx < 0 || s.eachPartWord(v[sIdx:], yield)
+}
+
+// Split v (which doesn't start or end with an underscore) on each delimiter and call yield for each word, after
+// attaching prefix to the first word and suffix to the last word.
+// When yield returns false, no more words are produced and false is returned.
+func (s *Splitter) eachAffixedWord(prefix, v, suffix string, yield func(string) bool) bool {
+	words := make([]string, 0)
+
+	s.eachWord(v, func(w string) bool {
+		words = append(words, w)
+
+		return true
+	})
+
+	// NOTE: When v doesn't have any words, the underscores are kept as a single word, instead of being lost.
+	if len(words) == 0 {
+		return yield(prefix + suffix)
+	}
+
+	words[0] = prefix + words[0]
+	words[len(words)-1] = words[len(words)-1] + suffix
+
+	for _, w := range words {
+		if !yield(w) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Returns the sigil at the start of v, or an empty string if v doesn't start with a sigil.
+func (s *Splitter) sigilPrefix(v string) string {
+	r, size := utf8.DecodeRuneInString(v)
+
+	if size == 0 || !slices.Contains(s.sigils, r) {
+		return ""
+	}
+
+	return v[:size]
+}
+
+// Returns the leading and the trailing underscores of v.
+// When v only consists of underscores, they are all returned as leading underscores.
+func underscoreAffixes(v string) (string, string) {
+	prefix := v[:len(v)-len(strings.TrimLeft(v, "_"))]
+	rest := v[len(prefix):]
+
+	return prefix, rest[len(strings.TrimRight(rest, "_")):]
+}
+
+// Checks whether or not r, the rune at position idx (in v), separates words.
+// Apostrophes and hyphens between 2 letters are part of a word when the Splitter is configured to treat them as such.
+func (s *Splitter) isDelimiterAt(v string, idx int, r rune) bool {
+	switch {
+	case s.apostrophes && isApostrophe(r):
+		return !isBetweenLetters(v, idx)
+	case s.hyphens && r == '-' && isBetweenLetters(v, idx):
+		return false
+	}
+
+	return s.isDelimiter(r)
+}
+
+// Checks whether or not the rune at position idx (in v) is preceded and followed by a letter.
+func isBetweenLetters(v string, idx int) bool {
+	prev, _ := utf8.DecodeLastRuneInString(v[:idx])
+	_, size := utf8.DecodeRuneInString(v[idx:])
+	next, _ := utf8.DecodeRuneInString(v[idx+size:])
+
+	return unicode.IsLetter(prev) && unicode.IsLetter(next)
+}
+
+// Call yield for each word of v (which doesn't contain any delimiters).
+// When yield returns false, no more words are produced and false is returned.
+func (s *Splitter) eachPartWord(v string, yield func(string) bool) bool {
+	vRdr := &rdr{
+		input:            v,
+		noSplit:          s.noSplitT,
+		wholeWords:       s.wholeWordsT,
+		scriptBoundaries: s.scripts,
+		numberLiterals:   s.literals,
+		versionTokens:    s.versions,
+		unitSuffixes:     s.unitsT,
+		apostrophes:      s.apostrophes,
+		hyphens:          s.hyphens,
+		classifier:       s.classifier,
+	}
+
+	sIdx, eIdx := -1, -1 // The position (in v) of the word that's held back, since the next part might be attached.
+	isNumber := false    // A flag indicating if the word that's held back is a number that's attached to the next word.
+	isMerged := false    // A flag indicating if the word that's held back is a single letter merged with the next word.
+
+	yieldHeld := func() bool {
+		if isMerged && s.letterMode == SingleLetterMerge {
+			_, size := utf8.DecodeRuneInString(v[sIdx:eIdx])
+
+			return yield(v[sIdx:sIdx+size] + s.cm.toLower(v[sIdx+size:eIdx]))
+		}
+
+		return yield(v[sIdx:eIdx])
+	}
+
+	for vRdr.pos < len(v) {
+		pIdx := vRdr.pos
+		part := vRdr.readNextPart()
+		r, _ := utf8.DecodeRuneInString(part)
+
+		switch {
+		case s.isDigitRune(r) && s.numberMode == NumberAttachPrevious && sIdx >= 0:
+			eIdx = vRdr.pos
+		case s.isDigitRune(r) && s.numberMode == NumberAttachNext:
+			if sIdx >= 0 && !isNumber && !yieldHeld() {
+				return false
+			}
+
+			if sIdx < 0 || !isNumber {
+				sIdx, isNumber, isMerged = pIdx, true, false
+			}
+
+			eIdx = vRdr.pos
+		case isNumber:
+			eIdx, isNumber = vRdr.pos, false
+		case s.letterMode != SingleLetterSplit && sIdx >= 0 && isSingleUpper(v[sIdx:eIdx]) && isCapitalized(part):
+			eIdx, isMerged = vRdr.pos, true
+		default:
+			if sIdx >= 0 && !yieldHeld() {
+				return false
+			}
+
+			sIdx, eIdx, isMerged = pIdx, vRdr.pos, false
+		}
+	}
+
+	return sIdx < 0 || yieldHeld()
+}
+
+// Checks whether or not r is a digit, according to the classifier of s (if any).
+func (s *Splitter) isDigitRune(r rune) bool {
+	if s.classifier != nil {
+		return s.classifier.IsDigit(r)
+	}
+
+	return isDigit(r)
+}
+
+// Checks whether or not w consists of a single uppercase letter.
+func isSingleUpper(w string) bool {
+	r, size := utf8.DecodeRuneInString(w)
+
+	return size == len(w) && unicode.IsUpper(r)
+}
+
+// Checks whether or not w starts with an uppercase letter that's followed by a lowercase letter (such as "Color").
+func isCapitalized(w string) bool {
+	r, size := utf8.DecodeRuneInString(w)
+	next, _ := utf8.DecodeRuneInString(w[size:])
+
+	return unicode.IsUpper(r) && unicode.IsLower(next)
+}
@@ -0,0 +1,1037 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"unicode"
+	"unsafe"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+	"golang.org/x/text/unicode/norm"
+)
+
+// UT: Split a string into a slice of words using a Splitter.
+func TestSplitterSplit(t *testing.T) {
+	for _, tc := range []struct {
+		vInput string
+		vOpts  []camelcase.Option
+		want   []string
+	}{
+		{
+			vInput: "",
+			want:   []string{""},
+		},
+		{
+			vInput: "PDFLoader",
+			want:   []string{"PDF", "Loader"},
+		},
+		{
+			vInput: "user_id-FromHTTPHeader",
+			want:   []string{"user", "id", "From", "HTTP", "Header"},
+		},
+		{
+			vInput: "__",
+			want:   []string{},
+		},
+		{
+			vInput: "user_id",
+			vOpts:  []camelcase.Option{camelcase.WithDelimiters()},
+			want:   []string{"user_id"},
+		},
+		{
+			vInput: "user+id_name",
+			vOpts:  []camelcase.Option{camelcase.WithDelimiters('+')},
+			want:   []string{"user", "id_name"},
+		},
+		{
+			vInput: "1Tls2IsUsed",
+			vOpts:  []camelcase.Option{camelcase.WithNoSplit("Tls2")},
+			want:   []string{"1", "Tls2", "Is", "Used"},
+		},
+		{
+			vInput: "user_id-FromHTTPHeader",
+			vOpts:  []camelcase.Option{camelcase.WithDelimiterTokens()},
+			want:   []string{"user", "_", "id", "-", "From", "HTTP", "Header"},
+		},
+		{
+			vInput: "__get_user. ",
+			vOpts:  []camelcase.Option{camelcase.WithDelimiterTokens(), camelcase.WithStopWords("get", "_")},
+			want:   []string{"__", "_", "user", ". "},
+		},
+		{
+			vInput: "USER'S 'quoted'_File’s",
+			vOpts:  []camelcase.Option{camelcase.WithApostrophes()},
+			want:   []string{"USER'S", "quoted", "File’s"},
+		},
+		{
+			vInput: "USER'S",
+			want:   []string{"USER", "'S"},
+		},
+		{
+			vInput: "X-RayScanner_COVID-19-Test_user-id_X-RAYImage",
+			vOpts:  []camelcase.Option{camelcase.WithIntraWordHyphens()},
+			want:   []string{"X-Ray", "Scanner", "COVID", "19", "Test", "user-id", "X-RAY", "Image"},
+		},
+		{
+			vInput: "X-RayScanner",
+			want:   []string{"X", "Ray", "Scanner"},
+		},
+		{
+			vInput: "_privateField",
+			vOpts:  []camelcase.Option{camelcase.WithUnderscoreAffixes()},
+			want:   []string{"_private", "Field"},
+		},
+		{
+			vInput: "__init__",
+			vOpts:  []camelcase.Option{camelcase.WithUnderscoreAffixes()},
+			want:   []string{"__init__"},
+		},
+		{
+			vInput: "__",
+			vOpts:  []camelcase.Option{camelcase.WithUnderscoreAffixes()},
+			want:   []string{"__"},
+		},
+		{
+			vInput: "__get_user_",
+			vOpts:  []camelcase.Option{camelcase.WithUnderscoreAffixes(), camelcase.WithStopWords("get")},
+			want:   []string{"__user_"},
+		},
+		{
+			vInput: "__init__",
+			want:   []string{"init"},
+		},
+		{
+			vInput: "$httpClient",
+			vOpts:  []camelcase.Option{camelcase.WithSigils()},
+			want:   []string{"$", "http", "Client"},
+		},
+		{
+			vInput: "@Injectable",
+			vOpts:  []camelcase.Option{camelcase.WithSigils(), camelcase.WithWordTransform(strings.ToLower)},
+			want:   []string{"@", "injectable"},
+		},
+		{
+			vInput: "$$_name",
+			vOpts:  []camelcase.Option{camelcase.WithSigils('$'), camelcase.WithUnderscoreAffixes()},
+			want:   []string{"$", "$", "_name"},
+		},
+		{
+			vInput: "@Injectable",
+			vOpts:  []camelcase.Option{camelcase.WithSigils('$')},
+			want:   []string{"@", "Injectable"},
+		},
+		{
+			vInput: "$httpClient",
+			want:   []string{"$http", "Client"},
+		},
+		{
+			vInput: "ASetOfColors_getAColor_ABTest",
+			want:   []string{"A", "Set", "Of", "Colors", "get", "A", "Color", "AB", "Test"},
+		},
+		{
+			vInput: "ASetOfColors_getAColor_ABTest",
+			vOpts:  []camelcase.Option{camelcase.WithSingleLetterMode(camelcase.SingleLetterKeep)},
+			want:   []string{"ASet", "Of", "Colors", "get", "AColor", "AB", "Test"},
+		},
+		{
+			vInput: "ASetOfColors_getAColor_A_Test",
+			vOpts:  []camelcase.Option{camelcase.WithSingleLetterMode(camelcase.SingleLetterMerge)},
+			want:   []string{"Aset", "Of", "Colors", "get", "Acolor", "A", "Test"},
+		},
+		{
+			vInput: "A1Test_X2",
+			vOpts: []camelcase.Option{
+				camelcase.WithSingleLetterMode(camelcase.SingleLetterMerge),
+				camelcase.WithNumberMode(camelcase.NumberAttachPrevious),
+			},
+			want: []string{"A1", "Test", "X2"},
+		},
+		{
+			vInput: "GetUserImpl_set_name",
+			vOpts:  []camelcase.Option{camelcase.WithStopWords("Get", "Set", "Impl")},
+			want:   []string{"User", "name"},
+		},
+		{
+			vInput: "GetUsersByNames",
+			vOpts: []camelcase.Option{
+				camelcase.WithWordTransform(strings.ToLower),
+				camelcase.WithWordTransform(func(w string) string { return strings.TrimSuffix(w, "s") }),
+			},
+			want: []string{"get", "user", "by", "name"},
+		},
+		{
+			vInput: "GetUserByName",
+			vOpts: []camelcase.Option{
+				camelcase.WithStopWords("by"),
+				camelcase.WithWordTransform(func(w string) string { return strings.TrimPrefix(w, "Get") }),
+			},
+			want: []string{"User", "Name"},
+		},
+		{
+			vInput: "parsehtmlfile_readHTMLfile_parsexmlfile",
+			vOpts:  []camelcase.Option{camelcase.WithDictionary("parse", "HTML", "file", "pars", "ehtml", "read")},
+			want:   []string{"parse", "html", "file", "read", "HTM", "Lfile", "parsexmlfile"},
+		},
+		{
+			vInput: "MYCONSTANTVALUE_myconstantvalue",
+			vOpts: []camelcase.Option{
+				camelcase.WithDictionary("my", "constant", "value"),
+				camelcase.WithUppercaseSegmentation(),
+			},
+			want: []string{"MY", "CONSTANT", "VALUE", "my", "constant", "value"},
+		},
+		{
+			vInput: "MYCONSTANTVALUE",
+			vOpts:  []camelcase.Option{camelcase.WithDictionary("my", "constant", "value")},
+			want:   []string{"MYCONSTANTVALUE"},
+		},
+		{
+			vInput: "getusername",
+			vOpts: []camelcase.Option{
+				camelcase.WithDictionary("get", "user", "username", "name"),
+				camelcase.WithStopWords("get"),
+			},
+			want: []string{"username"},
+		},
+		{
+			vInput: "TLS2Config_tls2-Tls2",
+			vOpts:  []camelcase.Option{camelcase.WithNoSplit("Tls2")},
+			want:   []string{"TLS", "2", "Config", "tls", "2", "Tls2"},
+		},
+		{
+			vInput: "TLS2Config_tls2-Tls2",
+			vOpts:  []camelcase.Option{camelcase.WithNoSplit("Tls2"), camelcase.WithCaseInsensitiveNoSplit()},
+			want:   []string{"TLS2", "Config", "tls2", "Tls2"},
+		},
+		{
+			vInput: "HttpCommFoo",
+			vOpts:  []camelcase.Option{camelcase.WithNoSplit("HttpCommunication")},
+			want:   []string{"HttpComm", "Foo"},
+		},
+		{
+			vInput: "HttpCommFoo_HttpCommunicationFoo",
+			vOpts:  []camelcase.Option{camelcase.WithWholeWords("HttpCommunication")},
+			want:   []string{"Http", "Comm", "Foo", "HttpCommunication", "Foo"},
+		},
+		{
+			vInput: "HttpCommFoo_HttpCommunicationFoo",
+			vOpts:  []camelcase.Option{camelcase.WithNoSplit("HttpCommunication"), camelcase.WithWholeWordMatching()},
+			want:   []string{"Http", "Comm", "Foo", "HttpCommunication", "Foo"},
+		},
+		{
+			vInput: "Tls2Config_Tls23_Tls2x",
+			vOpts:  []camelcase.Option{camelcase.WithWholeWords("Tls2")},
+			want:   []string{"Tls2", "Config", "Tls", "23", "Tls", "2", "x"},
+		},
+		{
+			vInput: "TLS2Config",
+			vOpts:  []camelcase.Option{camelcase.WithWholeWords("Tls2"), camelcase.WithCaseInsensitiveNoSplit()},
+			want:   []string{"TLS2", "Config"},
+		},
+		{
+			vInput: "OAuthTokenHandler",
+			vOpts:  []camelcase.Option{camelcase.WithAcronyms("OAuth")},
+			want:   []string{"OAuth", "Token", "Handler"},
+		},
+		{
+			vInput: "GL11Version",
+			vOpts:  []camelcase.Option{camelcase.WithNumberMode(camelcase.NumberSeparate)},
+			want:   []string{"GL", "11", "Version"},
+		},
+		{
+			vInput: "GL11Version_2",
+			vOpts:  []camelcase.Option{camelcase.WithNumberMode(camelcase.NumberAttachPrevious)},
+			want:   []string{"GL11", "Version", "2"},
+		},
+		{
+			vInput: "5May2000",
+			vOpts:  []camelcase.Option{camelcase.WithNumberMode(camelcase.NumberAttachPrevious)},
+			want:   []string{"5", "May2000"},
+		},
+		{
+			vInput: "5May2000",
+			vOpts:  []camelcase.Option{camelcase.WithNumberMode(camelcase.NumberAttachNext)},
+			want:   []string{"5May", "2000"},
+		},
+		{
+			vInput: "Version٣٢Build",
+			vOpts:  []camelcase.Option{camelcase.WithNumberMode(camelcase.NumberAttachPrevious)},
+			want:   []string{"Version٣٢", "Build"},
+		},
+		{
+			vInput: "Mask0xFFVariant_Flags0b1010_Mode0o755",
+			vOpts:  []camelcase.Option{camelcase.WithNumberLiterals()},
+			want:   []string{"Mask", "0xFF", "Variant", "Flags", "0b1010", "Mode", "0o755"},
+		},
+		{
+			vInput: "Mask0xFFVariant",
+			want:   []string{"Mask", "0", "xFF", "Variant"},
+		},
+		{
+			vInput: "DeploymentV1Beta1Spec_apps-v2alpha3_V2_V10Beta",
+			vOpts:  []camelcase.Option{camelcase.WithVersionTokens()},
+			want:   []string{"Deployment", "V1Beta1", "Spec", "apps", "v2alpha3", "V2", "V10", "Beta"},
+		},
+		{
+			vInput: "DeploymentV1Beta1Spec",
+			want:   []string{"Deployment", "V", "1", "Beta", "1", "Spec"},
+		},
+		{
+			vInput: "V2x_Vendor_V1BetaX",
+			vOpts:  []camelcase.Option{camelcase.WithVersionTokens()},
+			want:   []string{"V", "2", "x", "Vendor", "V1", "Beta", "X"},
+		},
+		{
+			vInput: "0xGood0b2",
+			vOpts:  []camelcase.Option{camelcase.WithNumberLiterals()},
+			want:   []string{"0", "x", "Good", "0", "b", "2"},
+		},
+		{
+			vInput: "Reg0x1F",
+			vOpts: []camelcase.Option{
+				camelcase.WithNumberLiterals(),
+				camelcase.WithNumberMode(camelcase.NumberAttachPrevious),
+			},
+			want: []string{"Reg0x1F"},
+		},
+		{
+			vInput: "GL11Version_2",
+			vOpts:  []camelcase.Option{camelcase.WithNumberMode(camelcase.NumberAttachNext)},
+			want:   []string{"GL", "11Version", "2"},
+		},
+		{
+			vInput: "E\u0301coleE\u0301le\u0301mentaire",
+			vOpts:  []camelcase.Option{camelcase.WithNormalization(norm.NFC)},
+			want:   []string{"\u00c9cole", "\u00c9l\u00e9mentaire"},
+		},
+		{
+			vInput: "\u00c9cole",
+			vOpts:  []camelcase.Option{camelcase.WithNormalization(norm.NFD)},
+			want:   []string{"E\u0301cole"},
+		},
+		{
+			vInput: "\ufb01leName\u2460",
+			vOpts:  []camelcase.Option{camelcase.WithNormalization(norm.NFKC)},
+			want:   []string{"file", "Name", "1"},
+		},
+		{
+			vInput: "E\u0301coleSuperieure",
+			vOpts: []camelcase.Option{
+				camelcase.WithNormalization(norm.NFC),
+				camelcase.WithNoSplit("\u00c9coleSuperieure"),
+			},
+			want: []string{"\u00c9coleSuperieure"},
+		},
+		{
+			vInput: "UserID用户名Test",
+			vOpts:  []camelcase.Option{camelcase.WithScriptBoundaries()},
+			want:   []string{"User", "ID", "用户名", "Test"},
+		},
+		{
+			vInput: "user用户名2ПриветМир",
+			vOpts:  []camelcase.Option{camelcase.WithScriptBoundaries()},
+			want:   []string{"user", "用户名", "2", "Привет", "Мир"},
+		},
+		{
+			vInput: "HTMLПарсер",
+			vOpts:  []camelcase.Option{camelcase.WithScriptBoundaries()},
+			want:   []string{"HTML", "Парсер"},
+		},
+		{
+			vInput: "ИмяUser",
+			vOpts:  []camelcase.Option{camelcase.WithScriptBoundaries()},
+			want:   []string{"Имя", "User"},
+		},
+		{
+			vInput: "dataβeta",
+			vOpts:  []camelcase.Option{camelcase.WithScriptBoundaries()},
+			want:   []string{"data", "β", "eta"},
+		},
+		{
+			vInput: "dataβeta",
+			vOpts:  []camelcase.Option{camelcase.WithScriptBoundaries(), camelcase.WithNoSplit("dataβeta")},
+			want:   []string{"dataβeta"},
+		},
+		{
+			vInput: "BadUTF8\xe2\xe2\xa1",
+			want:   []string{"BadUTF8\xe2\xe2\xa1"},
+		},
+		{
+			vInput: "BadUTF8\xe2\xe2\xa1",
+			vOpts:  []camelcase.Option{camelcase.WithInvalidUTF8(camelcase.InvalidUTF8Keep)},
+			want:   []string{"BadUTF8\xe2\xe2\xa1"},
+		},
+		{
+			vInput: "Bad\xffUserName",
+			vOpts:  []camelcase.Option{camelcase.WithInvalidUTF8(camelcase.InvalidUTF8Replace)},
+			want:   []string{"Bad\ufffd", "User", "Name"},
+		},
+		{
+			vInput: "Bad\xe2\xe2\xa1UserName",
+			vOpts:  []camelcase.Option{camelcase.WithInvalidUTF8(camelcase.InvalidUTF8Drop)},
+			want:   []string{"Bad", "User", "Name"},
+		},
+		{
+			vInput: "\xff",
+			vOpts:  []camelcase.Option{camelcase.WithInvalidUTF8(camelcase.InvalidUTF8Drop)},
+			want:   []string{""},
+		},
+	} {
+		// ACT.
+		got := camelcase.New(tc.vOpts...).Split(tc.vInput)
+
+		// ASSERT.
+		assert.EqualS(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Split a string into a slice of words using a Splitter.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Split a string using a Splitter, appending the words to an existing slice.
+func TestSplitterSplitInto(t *testing.T) {
+	for _, tc := range []struct {
+		vDst   []string
+		vInput string
+		vOpts  []camelcase.Option
+		want   []string
+	}{
+		{
+			vDst:   []string{"Existing"},
+			vInput: "",
+			want:   []string{"Existing", ""},
+		},
+		{
+			vDst:   []string{"Existing"},
+			vInput: "user_id-FromHTTPHeader",
+			want:   []string{"Existing", "user", "id", "From", "HTTP", "Header"},
+		},
+		{
+			vDst:   []string{"Existing"},
+			vInput: "User\xffName",
+			vOpts:  []camelcase.Option{camelcase.WithInvalidUTF8(camelcase.InvalidUTF8Drop)},
+			want:   []string{"Existing", "User", "Name"},
+		},
+		{
+			vInput: "BadUTF8\xe2\xe2\xa1",
+			want:   []string{"BadUTF8\xe2\xe2\xa1"},
+		},
+	} {
+		// ACT.
+		got := camelcase.New(tc.vOpts...).SplitInto(tc.vDst, tc.vInput)
+
+		// ASSERT.
+		assert.EqualS(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Split a string using a Splitter, appending the words to an existing slice.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Iterate over the words of a string using a Splitter.
+func TestSplitterSplitSeq(t *testing.T) {
+	for _, tc := range []struct {
+		vInput string
+		vOpts  []camelcase.Option
+		vMax   int
+		want   []string
+	}{
+		{
+			vInput: "",
+			vMax:   10,
+			want:   []string{""},
+		},
+		{
+			vInput: "user_id-FromHTTPHeader",
+			vMax:   10,
+			want:   []string{"user", "id", "From", "HTTP", "Header"},
+		},
+		{
+			vInput: "user_id-FromHTTPHeader",
+			vMax:   3,
+			want:   []string{"user", "id", "From"},
+		},
+		{
+			vInput: "GL11Version_2",
+			vOpts:  []camelcase.Option{camelcase.WithNumberMode(camelcase.NumberAttachPrevious)},
+			vMax:   1,
+			want:   []string{"GL11"},
+		},
+		{
+			vInput: "5May2000",
+			vOpts:  []camelcase.Option{camelcase.WithNumberMode(camelcase.NumberAttachNext)},
+			vMax:   1,
+			want:   []string{"5May"},
+		},
+		{
+			vInput: "User\xffName",
+			vOpts:  []camelcase.Option{camelcase.WithInvalidUTF8(camelcase.InvalidUTF8Drop)},
+			vMax:   10,
+			want:   []string{"User", "Name"},
+		},
+		{
+			vInput: "BadUTF8\xe2\xe2\xa1",
+			vMax:   10,
+			want:   []string{"BadUTF8\xe2\xe2\xa1"},
+		},
+	} {
+		// ACT.
+		got := make([]string, 0)
+
+		for w := range camelcase.New(tc.vOpts...).SplitSeq(tc.vInput) {
+			got = append(got, w)
+
+			if len(got) == tc.vMax {
+				break
+			}
+		}
+
+		// ASSERT.
+		assert.EqualS(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Iterate over the words of a string using a Splitter.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Convert a string using a Splitter.
+func TestSplitterConvert(t *testing.T) {
+	for _, tc := range []struct {
+		vInput string
+		vStyle camelcase.Style
+		vOpts  []camelcase.Option
+		want   string
+	}{
+		{
+			vInput: "html_parser",
+			vStyle: camelcase.Pascal,
+			want:   "HtmlParser",
+		},
+		{
+			vInput: "html_parser",
+			vStyle: camelcase.Pascal,
+			vOpts:  []camelcase.Option{camelcase.WithAcronyms("HTML")},
+			want:   "HTMLParser",
+		},
+		{
+			vInput: "html_parser",
+			vStyle: camelcase.Pascal,
+			vOpts:  []camelcase.Option{camelcase.WithDelimiterTokens()},
+			want:   "Html_Parser",
+		},
+		{
+			vInput: "can't open 'USER'S' file",
+			vStyle: camelcase.Title,
+			vOpts:  []camelcase.Option{camelcase.WithApostrophes()},
+			want:   "Can't Open USER'S File",
+		},
+		{
+			vInput: "can’t open user's file",
+			vStyle: camelcase.Pascal,
+			vOpts:  []camelcase.Option{camelcase.WithApostrophes()},
+			want:   "CantOpenUsersFile",
+		},
+		{
+			vInput: "can't open user's file",
+			vStyle: camelcase.Pascal,
+			want:   "Can'tOpenUser'sFile",
+		},
+		{
+			vInput: "_privateField",
+			vStyle: camelcase.Snake,
+			vOpts:  []camelcase.Option{camelcase.WithUnderscoreAffixes()},
+			want:   "_private_field",
+		},
+		{
+			vInput: "__init__",
+			vStyle: camelcase.Pascal,
+			vOpts:  []camelcase.Option{camelcase.WithUnderscoreAffixes()},
+			want:   "__Init__",
+		},
+		{
+			vInput: "__MAX_SIZE",
+			vStyle: camelcase.Camel,
+			vOpts:  []camelcase.Option{camelcase.WithUnderscoreAffixes()},
+			want:   "__maxSize",
+		},
+		{
+			vInput: "__init__",
+			vStyle: camelcase.Pascal,
+			want:   "Init",
+		},
+		{
+			vInput: "$httpClient",
+			vStyle: camelcase.Snake,
+			vOpts:  []camelcase.Option{camelcase.WithSigils()},
+			want:   "$http_client",
+		},
+		{
+			vInput: "user_id_from_http",
+			vStyle: camelcase.Pascal,
+			vOpts: []camelcase.Option{
+				camelcase.WithAcronyms("ID", "HTTP"),
+				camelcase.WithAcronymCase(camelcase.AcronymTitle),
+			},
+			want: "UserIdFromHttp",
+		},
+		{
+			vInput: "id_of_user",
+			vStyle: camelcase.Camel,
+			vOpts: []camelcase.Option{
+				camelcase.WithAcronyms("ID"),
+				camelcase.WithAcronymCase(camelcase.AcronymLower),
+			},
+			want: "idOfUser",
+		},
+		{
+			vInput: "user_id",
+			vStyle: camelcase.Camel,
+			vOpts: []camelcase.Option{
+				camelcase.WithAcronyms("ID"),
+				camelcase.WithAcronymCase(camelcase.AcronymLower),
+			},
+			want: "userId",
+		},
+		{
+			vInput: "user_id",
+			vStyle: camelcase.Camel,
+			vOpts:  []camelcase.Option{camelcase.WithAcronyms("ID")},
+			want:   "userID",
+		},
+		{
+			vInput: "getAColor",
+			vStyle: camelcase.Snake,
+			vOpts:  []camelcase.Option{camelcase.WithSingleLetterMode(camelcase.SingleLetterKeep)},
+			want:   "get_acolor",
+		},
+		{
+			vInput: "@MAX_SIZE",
+			vStyle: camelcase.Pascal,
+			vOpts:  []camelcase.Option{camelcase.WithSigils()},
+			want:   "@MaxSize",
+		},
+		{
+			vInput: "MAX_HTTP_SIZE",
+			vStyle: camelcase.Pascal,
+			vOpts:  []camelcase.Option{camelcase.WithAcronyms("HTTP")},
+			want:   "MaxHTTPSize",
+		},
+		{
+			vInput: "MAXHTTPSIZE",
+			vStyle: camelcase.Camel,
+			vOpts: []camelcase.Option{
+				camelcase.WithAcronyms("HTTP"),
+				camelcase.WithDictionary("max", "http", "size"),
+				camelcase.WithUppercaseSegmentation(),
+			},
+			want: "maxHTTPSize",
+		},
+		{
+			vInput: "oauth-token",
+			vStyle: camelcase.Camel,
+			vOpts:  []camelcase.Option{camelcase.WithAcronyms("OAuth")},
+			want:   "oauthToken",
+		},
+		{
+			vInput: "OAuthToken",
+			vStyle: camelcase.Snake,
+			vOpts:  []camelcase.Option{camelcase.WithAcronyms("OAuth")},
+			want:   "oauth_token",
+		},
+		{
+			vInput: "getUserHTMLImpl",
+			vStyle: camelcase.Kebab,
+			vOpts:  []camelcase.Option{camelcase.WithStopWords("get", "impl")},
+			want:   "user-html",
+		},
+		{
+			vInput: "İstanbulIlçesi",
+			vStyle: camelcase.Snake,
+			want:   "istanbul_ilçesi",
+		},
+		{
+			vInput: "İstanbulIlçesi",
+			vStyle: camelcase.Snake,
+			vOpts:  []camelcase.Option{camelcase.WithSpecialCase(unicode.TurkishCase)},
+			want:   "istanbul_ılçesi",
+		},
+		{
+			vInput: "istanbul_ili",
+			vStyle: camelcase.ScreamingSnake,
+			vOpts:  []camelcase.Option{camelcase.WithSpecialCase(unicode.TurkishCase)},
+			want:   "İSTANBUL_İLİ",
+		},
+		{
+			vInput: "istanbul_ili",
+			vStyle: camelcase.Pascal,
+			vOpts:  []camelcase.Option{camelcase.WithSpecialCase(unicode.AzeriCase)},
+			want:   "İstanbulİli",
+		},
+		{
+			vInput: "GL11Version",
+			vStyle: camelcase.Kebab,
+			vOpts:  []camelcase.Option{camelcase.WithNumberMode(camelcase.NumberAttachPrevious)},
+			want:   "gl11-version",
+		},
+		{
+			vInput: "BadUTF8\xe2\xe2\xa1",
+			vStyle: camelcase.Snake,
+			want:   "BadUTF8\xe2\xe2\xa1",
+		},
+		{
+			vInput: "User\xffName",
+			vStyle: camelcase.Snake,
+			vOpts:  []camelcase.Option{camelcase.WithInvalidUTF8(camelcase.InvalidUTF8Drop)},
+			want:   "user_name",
+		},
+	} {
+		// ACT.
+		got := camelcase.New(tc.vOpts...).Convert(tc.vInput, tc.vStyle)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Convert a string using a Splitter.\n"+
+			"Input:    %v (%v)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vStyle, tc.want, got)
+	}
+}
+
+// UT: Parse a string into words using a Splitter.
+func TestSplitterParse(t *testing.T) {
+	for _, tc := range []struct {
+		vInput string
+		vOpts  []camelcase.Option
+		want   string
+	}{
+		{
+			vInput: "",
+			want:   "",
+		},
+		{
+			vInput: "html_parser",
+			vOpts:  []camelcase.Option{camelcase.WithAcronyms("HTML")},
+			want:   "HTML Parser",
+		},
+		{
+			vInput: "istanbul_ili",
+			vOpts:  []camelcase.Option{camelcase.WithSpecialCase(unicode.TurkishCase)},
+			want:   "İstanbul İli",
+		},
+		{
+			vInput: "BadUTF8\xe2\xe2\xa1",
+			want:   "BadUTF8\xe2\xe2\xa1",
+		},
+		{
+			vInput: "user\xffName",
+			vOpts:  []camelcase.Option{camelcase.WithInvalidUTF8(camelcase.InvalidUTF8Replace)},
+			want:   "User\ufffd Name",
+		},
+	} {
+		// ACT.
+		got := camelcase.New(tc.vOpts...).Parse(tc.vInput).Title()
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Parse a string into words using a Splitter.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Convert a string into a human readable string using a Splitter.
+func TestSplitterHumanize(t *testing.T) {
+	for _, tc := range []struct {
+		vInput string
+		vOpts  []camelcase.Option
+		want   string
+	}{
+		{
+			vInput: "",
+			want:   "",
+		},
+		{
+			vInput: "userID_fromHTTPHeader",
+			want:   "User ID from HTTP header",
+		},
+		{
+			vInput: "userID_fromHTTPHeader",
+			vOpts:  []camelcase.Option{camelcase.WithAcronymCase(camelcase.AcronymTitle)},
+			want:   "User Id from Http header",
+		},
+		{
+			vInput: "id_of_user",
+			vOpts:  []camelcase.Option{camelcase.WithAcronymCase(camelcase.AcronymLower)},
+			want:   "Id of user",
+		},
+		{
+			vInput: "user_id_on_ios",
+			vOpts: []camelcase.Option{
+				camelcase.WithAcronyms("ID", "iOS"),
+				camelcase.WithAcronymCase(camelcase.AcronymLower),
+			},
+			want: "User id on ios",
+		},
+		{
+			vInput: "user_id_on_ios",
+			vOpts:  []camelcase.Option{camelcase.WithAcronyms("ID", "iOS")},
+			want:   "User ID on iOS",
+		},
+		{
+			vInput: "MY_ID",
+			want:   "My id",
+		},
+		{
+			vInput: "BadUTF8\xe2\xe2\xa1",
+			want:   "BadUTF8\xe2\xe2\xa1",
+		},
+	} {
+		// ACT.
+		got := camelcase.New(tc.vOpts...).Humanize(tc.vInput)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Convert a string into a human readable string using a Splitter.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Split a string using a Splitter that copies the words.
+func TestSplitterClonedWords(t *testing.T) {
+	for _, tc := range []struct {
+		vOpts []camelcase.Option
+		want  bool
+	}{
+		{
+			want: true,
+		},
+		{
+			vOpts: []camelcase.Option{camelcase.WithClonedWords()},
+			want:  false,
+		},
+		{
+			vOpts: []camelcase.Option{camelcase.WithClonedWords(), camelcase.WithCache(2)},
+			want:  false,
+		},
+	} {
+		// ARRANGE.
+		input := strings.Repeat("HelloWorld", 2)
+		start := uintptr(unsafe.Pointer(unsafe.StringData(input)))
+		splitter := camelcase.New(tc.vOpts...)
+
+		// ACT.
+		_ = splitter.Split(input)
+		words := splitter.Split(input)
+
+		// ASSERT.
+		for _, w := range words {
+			wStart := uintptr(unsafe.Pointer(unsafe.StringData(w)))
+			got := wStart >= start && wStart < start+uintptr(len(input))
+
+			assert.Equal(t, got, tc.want, "", "\n\n"+
+				"UT Name:  Split a string using a Splitter that copies the words.\n"+
+				"Input:    %v (word: %v)\n"+
+				"\033[32mExpected (shares memory): %v\033[0m\n"+
+				"\033[31mActual (shares memory):   %v\033[0m\n\n", input, w, tc.want, got)
+		}
+	}
+}
+
+// UT: Split strings using a Splitter with a cache.
+func TestSplitterCache(t *testing.T) {
+	// ARRANGE.
+	splitter := camelcase.New(camelcase.WithCache(2), camelcase.WithAcronyms("HTML"))
+
+	for _, tc := range []struct {
+		vInput string
+		want   []string
+	}{
+		{vInput: "userID", want: []string{"user", "ID"}},
+		{vInput: "HTMLParser", want: []string{"HTML", "Parser"}},
+		{vInput: "userID", want: []string{"user", "ID"}},
+		{vInput: "created_at", want: []string{"created", "at"}},
+		{vInput: "HTMLParser", want: []string{"HTML", "Parser"}},
+		{vInput: "userID", want: []string{"user", "ID"}},
+	} {
+		// ACT.
+		got := splitter.Split(tc.vInput)
+		got[0] = "Modified" // NOTE: Modifying the words must not modify the cached words.
+
+		// ASSERT.
+		got = splitter.Split(tc.vInput)
+
+		assert.EqualS(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Split strings using a Splitter with a cache.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Split strings concurrently using a Splitter with a cache.
+func TestSplitterCacheConcurrent(t *testing.T) {
+	// ARRANGE.
+	splitter := camelcase.New(camelcase.WithCache(4))
+	inputs := []string{"userID", "HTMLParser", "createdAt", "GL11Version", "PDFLoader", "ÜberWichtig"}
+	results := make([][]string, 8*len(inputs))
+
+	var wg sync.WaitGroup
+
+	// ACT.
+	for i := range results {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			results[i] = splitter.Split(inputs[i%len(inputs)])
+		}()
+	}
+
+	wg.Wait()
+
+	// ASSERT.
+	for i, got := range results {
+		want := camelcase.Split(inputs[i%len(inputs)])
+
+		assert.EqualS(t, got, want, "", "\n\n"+
+			"UT Name:  Split strings concurrently using a Splitter with a cache.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", inputs[i%len(inputs)], want, got)
+	}
+}
+
+// Benchmark: Split a "CamelCase" string using a Splitter.
+func BenchmarkSplitterSplit(b *testing.B) {
+	// ARRANGE.
+	var s strings.Builder
+
+	for i := 0; i < 1_000; i++ {
+		s.WriteString("HelloWorld99HTML")
+	}
+
+	input := s.String()
+	splitter := camelcase.New(camelcase.WithAcronyms("HTML", "JSON", "XML"))
+
+	// RESET.
+	b.ResetTimer()
+
+	// EXECUTION.
+	for i := 0; i < b.N; i++ {
+		_ = splitter.Split(input)
+	}
+}
+
+// Benchmark: Split a "CamelCase" string using a Splitter, reusing the result slice.
+func BenchmarkSplitterSplitInto(b *testing.B) {
+	// ARRANGE.
+	var s strings.Builder
+
+	for i := 0; i < 1_000; i++ {
+		s.WriteString("HelloWorld99HTML")
+	}
+
+	input := s.String()
+	splitter := camelcase.New(camelcase.WithAcronyms("HTML", "JSON", "XML"))
+	dst := make([]string, 0)
+
+	// RESET.
+	b.ResetTimer()
+
+	// EXECUTION.
+	for i := 0; i < b.N; i++ {
+		dst = splitter.SplitInto(dst[:0], input)
+	}
+}
+
+// Benchmark: Split the same identifiers repeatedly using a Splitter with a cache.
+func BenchmarkSplitterSplitCached(b *testing.B) {
+	// ARRANGE.
+	inputs := []string{"UserID", "CreatedAt", "HTMLParser", "GL11Version", "ProfileImageURL", "LastLoginTimestamp"}
+	splitter := camelcase.New(camelcase.WithAcronyms("HTML", "URL"), camelcase.WithCache(len(inputs)))
+
+	// RESET.
+	b.ResetTimer()
+
+	// EXECUTION.
+	for i := 0; i < b.N; i++ {
+		_ = splitter.Split(inputs[i%len(inputs)])
+	}
+}
+
+// Benchmark: Split the same identifiers repeatedly using a Splitter without a cache.
+func BenchmarkSplitterSplitUncached(b *testing.B) {
+	// ARRANGE.
+	inputs := []string{"UserID", "CreatedAt", "HTMLParser", "GL11Version", "ProfileImageURL", "LastLoginTimestamp"}
+	splitter := camelcase.New(camelcase.WithAcronyms("HTML", "URL"))
+
+	// RESET.
+	b.ResetTimer()
+
+	// EXECUTION.
+	for i := 0; i < b.N; i++ {
+		_ = splitter.Split(inputs[i%len(inputs)])
+	}
+}
+
+// Benchmark: Split a "CamelCase" string using a Splitter with a large number of words that shouldn't be split.
+func BenchmarkSplitterSplitManyNoSplit(b *testing.B) {
+	// ARRANGE.
+	var s strings.Builder
+
+	for i := 0; i < 1_000; i++ {
+		s.WriteString("HelloWorld99HTML")
+	}
+
+	input := s.String()
+	noSplit := make([]string, 0, 500)
+
+	for i := 0; i < 500; i++ {
+		noSplit = append(noSplit, "Protected"+strings.Repeat("X", i%10)+string(rune('A'+i%26))+"Word")
+	}
+
+	splitter := camelcase.New(camelcase.WithNoSplit(noSplit...))
+
+	// RESET.
+	b.ResetTimer()
+
+	// EXECUTION.
+	for i := 0; i < b.N; i++ {
+		_ = splitter.Split(input)
+	}
+}
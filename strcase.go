@@ -0,0 +1,167 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import "strings"
+
+// Returns v converted into the naming convention to, the way github.com/iancoleman/strcase converts it, and a flag
+// indicating if that package has an equivalent for to.
+func strcaseConvert(v string, to Style) (string, bool) {
+	switch to {
+	case Camel:
+		return strcaseCamel(v, false), true
+	case Pascal:
+		return strcaseCamel(v, true), true
+	case Snake:
+		return strcaseDelimited(v, '_', false), true
+	case ScreamingSnake:
+		return strcaseDelimited(v, '_', true), true
+	case Kebab:
+		return strcaseDelimited(v, '-', false), true
+	case Dot:
+		return strcaseDelimited(v, '.', false), true
+	}
+
+	return "", false
+}
+
+// Returns v with its words joined using delimiter, the way github.com/iancoleman/strcase's ToScreamingDelimited does.
+// The input is processed byte by byte: ASCII letters are converted to lowercase (or to uppercase when screaming is
+// true), a delimiter is inserted when the case type (lowercase, uppercase or digit) changes, and each space,
+// underscore, dash or dot is replaced by delimiter. Any other byte is kept as it is.
+func strcaseDelimited(v string, delimiter byte, screaming bool) string {
+	v = strings.TrimSpace(v)
+
+	var sb strings.Builder
+
+	sb.Grow(len(v) + 2)
+
+	for idx := 0; idx < len(v); idx++ {
+		b := v[idx]
+		bIsUpper, bIsLower := isASCIIUpper(b), isASCIILower(b)
+
+		switch {
+		case bIsLower && screaming:
+			b = b - 'a' + 'A'
+		case bIsUpper && !screaming:
+			b = b - 'A' + 'a'
+		}
+
+		// NOTE: When the case type changes, a delimiter is written after a lowercase letter or a digit, and before an
+		// uppercase letter that follows an uppercase letter and precedes a lowercase letter ("JSONData" → "json_data").
+		if idx+1 < len(v) {
+			next := v[idx+1]
+			bIsDigit := isASCIIDigit(b)
+			nextIsUpper, nextIsLower, nextIsDigit := isASCIIUpper(next), isASCIILower(next), isASCIIDigit(next)
+
+			if (bIsUpper && (nextIsLower || nextIsDigit)) || (bIsLower && (nextIsUpper || nextIsDigit)) ||
+				(bIsDigit && (nextIsUpper || nextIsLower)) {
+				if bIsUpper && nextIsLower && idx > 0 && isASCIIUpper(v[idx-1]) {
+					sb.WriteByte(delimiter)
+				}
+
+				sb.WriteByte(b)
+
+				if bIsLower || bIsDigit || nextIsDigit {
+					sb.WriteByte(delimiter)
+				}
+
+				continue
+			}
+		}
+
+		if b == ' ' || b == '_' || b == '-' || b == '.' {
+			sb.WriteByte(delimiter)
+		} else {
+			sb.WriteByte(b)
+		}
+	}
+
+	return sb.String()
+}
+
+// Returns v in "CamelCase", the way github.com/iancoleman/strcase's ToCamel (or ToLowerCamel, when upperFirst is
+// false) does.
+// The input is processed byte by byte: a space, underscore, dash or dot (which is dropped) and a digit make the next
+// letter uppercase, each uppercase letter that follows an uppercase letter is converted to lowercase, and any byte that
+// isn't an ASCII letter or digit is dropped.
+func strcaseCamel(v string, upperFirst bool) string {
+	v = strings.TrimSpace(v)
+
+	var sb strings.Builder
+
+	sb.Grow(len(v))
+
+	capNext, prevIsUpper := upperFirst, false
+
+	for idx := 0; idx < len(v); idx++ {
+		b := v[idx]
+		bIsUpper, bIsLower := isASCIIUpper(b), isASCIILower(b)
+
+		switch {
+		case capNext:
+			if bIsLower {
+				b = b - 'a' + 'A'
+			}
+		case idx == 0 || prevIsUpper:
+			if bIsUpper {
+				b = b - 'A' + 'a'
+			}
+		}
+
+		prevIsUpper = bIsUpper
+
+		switch {
+		case bIsUpper || bIsLower:
+			sb.WriteByte(b)
+
+			capNext = false
+		case isASCIIDigit(b):
+			sb.WriteByte(b)
+
+			capNext = true
+		default:
+			capNext = b == ' ' || b == '_' || b == '-' || b == '.'
+		}
+	}
+
+	return sb.String()
+}
+
+// Checks whether or not b is an ASCII uppercase letter.
+func isASCIIUpper(b byte) bool {
+	return b >= 'A' && b <= 'Z'
+}
+
+// Checks whether or not b is an ASCII lowercase letter.
+func isASCIILower(b byte) bool {
+	return b >= 'a' && b <= 'z'
+}
+
+// Checks whether or not b is an ASCII digit.
+func isASCIIDigit(b byte) bool {
+	return b >= '0' && b <= '9'
+}
@@ -0,0 +1,115 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Convert a string in strcase compatibility mode.
+// NOTE: The expectations are the ones produced by github.com/iancoleman/strcase (v0.3.0).
+func TestSplitterStrcaseCompat(t *testing.T) {
+	for _, tc := range []struct {
+		vInput string
+		vStyle camelcase.Style
+		want   string
+	}{
+		{vInput: "", vStyle: camelcase.Snake, want: ""},
+		{vInput: "testCase", vStyle: camelcase.Snake, want: "test_case"},
+		{vInput: "TestCase", vStyle: camelcase.Snake, want: "test_case"},
+		{vInput: " Test Case ", vStyle: camelcase.Snake, want: "test_case"},
+		{vInput: "test_case", vStyle: camelcase.Snake, want: "test_case"},
+		{vInput: "ManyManyWords", vStyle: camelcase.Snake, want: "many_many_words"},
+		{vInput: "AnyKind of_string", vStyle: camelcase.Snake, want: "any_kind_of_string"},
+		{vInput: "numbers2and55with000", vStyle: camelcase.Snake, want: "numbers_2_and_55_with_000"},
+		{vInput: "JSONData", vStyle: camelcase.Snake, want: "json_data"},
+		{vInput: "userID", vStyle: camelcase.Snake, want: "user_id"},
+		{vInput: "AAAbbb", vStyle: camelcase.Snake, want: "aa_abbb"},
+		{vInput: "1A2", vStyle: camelcase.Snake, want: "1_a_2"},
+		{vInput: "A1B", vStyle: camelcase.Snake, want: "a_1_b"},
+		{vInput: "A1A2A3", vStyle: camelcase.Snake, want: "a_1_a_2_a_3"},
+		{vInput: "A1 A2 A3", vStyle: camelcase.Snake, want: "a_1_a_2_a_3"},
+		{vInput: "AB1AB2AB3", vStyle: camelcase.Snake, want: "ab_1_ab_2_ab_3"},
+		{vInput: "AB1 AB2 AB3", vStyle: camelcase.Snake, want: "ab_1_ab_2_ab_3"},
+		{vInput: "__init__", vStyle: camelcase.Snake, want: "__init__"},
+		{vInput: "ÜberCool", vStyle: camelcase.Snake, want: "Über_cool"},
+		{vInput: "testCase", vStyle: camelcase.ScreamingSnake, want: "TEST_CASE"},
+		{vInput: "JSONData", vStyle: camelcase.ScreamingSnake, want: "JSON_DATA"},
+		{vInput: "numbers2and55with000", vStyle: camelcase.ScreamingSnake, want: "NUMBERS_2_AND_55_WITH_000"},
+		{vInput: "testCase", vStyle: camelcase.Kebab, want: "test-case"},
+		{vInput: "AnyKind of_string", vStyle: camelcase.Kebab, want: "any-kind-of-string"},
+		{vInput: "testCase", vStyle: camelcase.Dot, want: "test.case"},
+		{vInput: "", vStyle: camelcase.Pascal, want: ""},
+		{vInput: "test_case", vStyle: camelcase.Pascal, want: "TestCase"},
+		{vInput: "test.case", vStyle: camelcase.Pascal, want: "TestCase"},
+		{vInput: "test", vStyle: camelcase.Pascal, want: "Test"},
+		{vInput: "TestCase", vStyle: camelcase.Pascal, want: "TestCase"},
+		{vInput: " test  case ", vStyle: camelcase.Pascal, want: "TestCase"},
+		{vInput: "many_many_words", vStyle: camelcase.Pascal, want: "ManyManyWords"},
+		{vInput: "AnyKind of_string", vStyle: camelcase.Pascal, want: "AnyKindOfString"},
+		{vInput: "odd-fix", vStyle: camelcase.Pascal, want: "OddFix"},
+		{vInput: "numbers2And55with000", vStyle: camelcase.Pascal, want: "Numbers2And55With000"},
+		{vInput: "ID", vStyle: camelcase.Pascal, want: "Id"},
+		{vInput: "CONSTANT_CASE", vStyle: camelcase.Pascal, want: "ConstantCase"},
+		{vInput: "userID", vStyle: camelcase.Pascal, want: "UserId"},
+		{vInput: "foo-bar", vStyle: camelcase.Camel, want: "fooBar"},
+		{vInput: "TestCase", vStyle: camelcase.Camel, want: "testCase"},
+		{vInput: "AnyKind.of-string", vStyle: camelcase.Camel, want: "anyKindOfString"},
+		{vInput: "ID", vStyle: camelcase.Camel, want: "id"},
+		{vInput: " some string", vStyle: camelcase.Camel, want: "someString"},
+		{vInput: "CONSTANT_CASE", vStyle: camelcase.Camel, want: "constantCase"},
+		{vInput: "über_cool", vStyle: camelcase.Camel, want: "berCool"},
+		{vInput: "JSONData", vStyle: camelcase.Train, want: "JSON-Data"},
+	} {
+		// ACT.
+		got := camelcase.New(camelcase.WithStrcaseCompat()).Convert(tc.vInput, tc.vStyle)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Convert a string in strcase compatibility mode.\n"+
+			"Input:    %v (%v)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vStyle, tc.want, got)
+	}
+}
+
+// Benchmark: Convert a string in strcase compatibility mode.
+func BenchmarkSplitterStrcaseCompat(b *testing.B) {
+	// ARRANGE.
+	s := camelcase.New(camelcase.WithStrcaseCompat())
+
+	// RESET.
+	b.ResetTimer()
+
+	// EXECUTION.
+	for i := 0; i < b.N; i++ {
+		_ = s.Convert("numbers2And55with000_JSONData", camelcase.Snake)
+	}
+}
@@ -0,0 +1,171 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Style represents a naming convention (the way in which words are combined into a single string).
+type Style int
+
+// The supported naming conventions.
+const (
+	Unknown        Style = iota // An unknown (or mixed) naming convention.
+	Camel                       // "camelCase"
+	Pascal                      // "PascalCase"
+	Snake                       // "snake_case"
+	ScreamingSnake              // "SCREAMING_SNAKE_CASE"
+	Kebab                       // "kebab-case"
+	Train                       // "Train-Case"
+	Dot                         // "dot.case"
+	Path                        // "path/case"
+	Space                       // "space case"
+	Title                       // "Title Case"
+)
+
+// The names of the supported naming conventions.
+var styleNames = [...]string{
+	Unknown:        "Unknown",
+	Camel:          "Camel",
+	Pascal:         "Pascal",
+	Snake:          "Snake",
+	ScreamingSnake: "ScreamingSnake",
+	Kebab:          "Kebab",
+	Train:          "Train",
+	Dot:            "Dot",
+	Path:           "Path",
+	Space:          "Space",
+	Title:          "Title",
+}
+
+// String returns the name of s.
+func (s Style) String() string {
+	if s < 0 || int(s) >= len(styleNames) {
+		return "Style(" + strconv.Itoa(int(s)) + ")"
+	}
+
+	return styleNames[s]
+}
+
+// Detect inspects v and reports the naming convention it's written in.
+// A string without delimiters is reported as Camel when it starts with a lowercase rune, as Pascal when it starts with
+// an uppercase rune and contains lowercase runes and as ScreamingSnake when it doesn't contain any lowercase runes.
+// A single lowercase word (such as "name") is valid in multiple naming conventions and is reported as Camel.
+// If v is empty, isn't a valid UTF-8 string, mixes delimiters, contains empty words or contains runes that aren't
+// letters, digits or delimiters, Unknown is returned.
+func Detect(v string) Style {
+	if !utf8.ValidString(v) || len(v) == 0 {
+		return Unknown
+	}
+
+	sep := rune(0)
+
+	for _, r := range v {
+		if isDelimiter(r) {
+			if sep != 0 && r != sep {
+				return Unknown
+			}
+
+			sep = r
+		} else if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return Unknown
+		}
+	}
+
+	if sep == 0 {
+		return detectUndelimited(v)
+	}
+
+	return detectDelimited(strings.Split(v, string(sep)), sep)
+}
+
+// Reports the naming convention of v, which doesn't contain any delimiters.
+func detectUndelimited(v string) Style {
+	r, _ := utf8.DecodeRuneInString(v)
+
+	switch {
+	case unicode.IsLower(r):
+		return Camel
+	case !unicode.IsUpper(r) && !unicode.IsTitle(r):
+		return Unknown
+	case hasLower(v):
+		return Pascal
+	}
+
+	return ScreamingSnake
+}
+
+// Reports the naming convention of the words in parts, which were separated using sep.
+func detectDelimited(parts []string, sep rune) Style {
+	lower, upper, title := true, true, true
+
+	for _, p := range parts {
+		if len(p) == 0 {
+			return Unknown
+		}
+
+		r, _ := utf8.DecodeRuneInString(p)
+
+		lower = lower && !hasUpper(p)
+		upper = upper && !hasLower(p)
+		title = title && !unicode.IsLower(r)
+	}
+
+	switch {
+	case sep == '_' && lower:
+		return Snake
+	case sep == '_' && upper:
+		return ScreamingSnake
+	case sep == '-' && lower:
+		return Kebab
+	case sep == '-' && title:
+		return Train
+	case sep == '.' && lower:
+		return Dot
+	case sep == '/' && lower:
+		return Path
+	case sep == ' ' && lower:
+		return Space
+	case sep == ' ' && title:
+		return Title
+	}
+
+	return Unknown
+}
+
+// Checks whether or not v contains a lowercase rune.
+func hasLower(v string) bool {
+	return strings.IndexFunc(v, unicode.IsLower) >= 0
+}
+
+// Checks whether or not v contains an uppercase (or titlecase) rune.
+func hasUpper(v string) bool {
+	return strings.IndexFunc(v, func(r rune) bool { return unicode.IsUpper(r) || unicode.IsTitle(r) }) >= 0
+}
@@ -0,0 +1,100 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Get the name of a naming convention.
+func TestStyleString(t *testing.T) {
+	for _, tc := range []struct {
+		vInput camelcase.Style
+		want   string
+	}{
+		{vInput: camelcase.Unknown, want: "Unknown"},
+		{vInput: camelcase.Camel, want: "Camel"},
+		{vInput: camelcase.ScreamingSnake, want: "ScreamingSnake"},
+		{vInput: camelcase.Title, want: "Title"},
+		{vInput: camelcase.Style(-1), want: "Style(-1)"},
+		{vInput: camelcase.Style(100), want: "Style(100)"},
+	} {
+		// ACT.
+		got := tc.vInput.String()
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Get the name of a naming convention.\n"+
+			"Input:    %d\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", int(tc.vInput), tc.want, got)
+	}
+}
+
+// UT: Detect the naming convention of a string.
+func TestDetect(t *testing.T) {
+	for _, tc := range []struct {
+		vInput string
+		want   camelcase.Style
+	}{
+		{vInput: "", want: camelcase.Unknown},
+		{vInput: "name", want: camelcase.Camel},
+		{vInput: "userID", want: camelcase.Camel},
+		{vInput: "UserID", want: camelcase.Pascal},
+		{vInput: "HTML", want: camelcase.ScreamingSnake},
+		{vInput: "user_id", want: camelcase.Snake},
+		{vInput: "USER_ID", want: camelcase.ScreamingSnake},
+		{vInput: "user-id", want: camelcase.Kebab},
+		{vInput: "User-ID", want: camelcase.Train},
+		{vInput: "user.id", want: camelcase.Dot},
+		{vInput: "user/id", want: camelcase.Path},
+		{vInput: "user id", want: camelcase.Space},
+		{vInput: "User ID", want: camelcase.Title},
+		{vInput: "version_2", want: camelcase.Snake},
+		{vInput: "User_id", want: camelcase.Unknown},
+		{vInput: "user_id-name", want: camelcase.Unknown},
+		{vInput: "_private", want: camelcase.Unknown},
+		{vInput: "user__id", want: camelcase.Unknown},
+		{vInput: "user.Id", want: camelcase.Unknown},
+		{vInput: "2ndPlace", want: camelcase.Unknown},
+		{vInput: "$httpClient", want: camelcase.Unknown},
+		{vInput: "BadUTF8\xe2\xe2\xa1", want: camelcase.Unknown},
+	} {
+		// ACT.
+		got := camelcase.Detect(tc.vInput)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Detect the naming convention of a string.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
@@ -0,0 +1,86 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import "strings"
+
+// The English words that aren't capitalized in a title (unless they start the title).
+var englishSmallWords = []string{
+	"a", "an", "and", "as", "at", "but", "by", "for", "in", "nor", "of", "on", "or", "per", "so", "the", "to", "up",
+	"via", "vs", "yet",
+}
+
+// EnglishSmallWords returns the English words that Titleize doesn't capitalize (unless they start the title), such as
+// "of", "the" and "and".
+// The returned slice is a copy and can be modified safely.
+func EnglishSmallWords() []string {
+	return append([]string(nil), englishSmallWords...)
+}
+
+// Titleize converts v into a title, suitable for display purposes (such as the title of a page).
+// The words are determined like Humanize determines them, and each word starts with an uppercase rune, except the
+// words that equal (ignoring case) a word in smallWords (such as "of" or "the"), which are written in lowercase unless
+// they start the title. So "listOfTheUsers" becomes "List of the Users".
+// If no smallWords are provided, the English small words (see EnglishSmallWords) are used.
+// If v isn't a valid UTF-8 string, v is returned unmodified.
+func Titleize(v string, smallWords ...string) string {
+	return New(WithApostrophes()).Titleize(v, smallWords...)
+}
+
+// Titleize splits v into words and converts them into a title, suitable for display purposes.
+// The words are determined like Humanize determines them, and each word starts with an uppercase rune, except the
+// words that equal (ignoring case) a word in smallWords, which are written in lowercase unless they start the title.
+// If no smallWords are provided, the English small words (see EnglishSmallWords) are used.
+// If v isn't a valid UTF-8 string (and the Splitter isn't configured to repair it), v is returned unmodified.
+func (s *Splitter) Titleize(v string, smallWords ...string) string {
+	v, ok := s.repair(v)
+
+	if !ok {
+		return v
+	}
+
+	if len(smallWords) == 0 {
+		smallWords = englishSmallWords
+	}
+
+	var sb strings.Builder
+
+	for idx, w := range s.humanWords(v) {
+		wc := upperFirstCase
+
+		if idx > 0 {
+			sb.WriteByte(' ')
+
+			if isListed(w, smallWords) {
+				wc = lowerCase
+			}
+		}
+
+		s.cm.write(&sb, w, wc)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,116 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Convert a string into a title.
+func TestTitleize(t *testing.T) {
+	for _, tc := range []struct {
+		vInput      string
+		vSmallWords []string
+		want        string
+	}{
+		{vInput: "", want: ""},
+		{vInput: "listUsers", want: "List Users"},
+		{vInput: "listOfTheUsers", want: "List of the Users"},
+		{vInput: "the_lord_of_the_rings", want: "The Lord of the Rings"},
+		{vInput: "THE_LORD_OF_THE_RINGS", want: "The Lord of the Rings"},
+		{vInput: "AndThenThereWereNone", want: "And Then There Were None"},
+		{vInput: "showHTMLPageForUserID", want: "Show HTML Page for User ID"},
+		{vInput: "handle user's profile", want: "Handle User's Profile"},
+		{vInput: "searchInFilesByName", vSmallWords: []string{"in"}, want: "Search in Files By Name"},
+		{vInput: "saveAndExit", vSmallWords: []string{"AND"}, want: "Save and Exit"},
+		{vInput: "user\xffname", want: "user\xffname"},
+	} {
+		// ACT.
+		got := camelcase.Titleize(tc.vInput, tc.vSmallWords...)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Convert a string into a title.\n"+
+			"Input:    %v (%v)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vSmallWords, tc.want, got)
+	}
+}
+
+// UT: Convert a string into a title, using a Splitter.
+func TestSplitterTitleize(t *testing.T) {
+	for _, tc := range []struct {
+		vInput string
+		vOpts  []camelcase.Option
+		want   string
+	}{
+		{vInput: "getUserIdForTheAPI", want: "Get User Id for the API"},
+		{
+			vInput: "getUserIdForTheAPI",
+			vOpts:  []camelcase.Option{camelcase.WithAcronyms("ID")},
+			want:   "Get User ID for the API",
+		},
+		{
+			vInput: "getUserIdForTheAPI",
+			vOpts:  []camelcase.Option{camelcase.WithAcronyms("ID"), camelcase.WithAcronymCase(camelcase.AcronymTitle)},
+			want:   "Get User Id for the Api",
+		},
+		{
+			vInput: "user\xffname_of_the_day",
+			vOpts:  []camelcase.Option{camelcase.WithInvalidUTF8(camelcase.InvalidUTF8Drop)},
+			want:   "Username of the Day",
+		},
+	} {
+		// ACT.
+		got := camelcase.New(tc.vOpts...).Titleize(tc.vInput)
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Convert a string into a title, using a Splitter.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Modifying the slice returned by EnglishSmallWords doesn't modify the English small words.
+func TestEnglishSmallWordsIsCopy(t *testing.T) {
+	// ARRANGE.
+	camelcase.EnglishSmallWords()[0] = "Changed"
+
+	// ACT.
+	got := camelcase.EnglishSmallWords()[0]
+
+	// ASSERT.
+	assert.Equal(t, got, "a", "", "\n\n"+
+		"UT Name:  Modifying the slice returned by EnglishSmallWords doesn't modify the English small words.\n"+
+		"\033[32mExpected: %v\033[0m\n"+
+		"\033[31mActual:   %v\033[0m\n\n", "a", got)
+}
@@ -0,0 +1,162 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import (
+	"strconv"
+	"unicode/utf8"
+)
+
+// TokenKind defines the kind of a Token.
+type TokenKind int
+
+// The supported kinds of tokens.
+const (
+	TokenWord      TokenKind = iota // A word, such as "Parser" or "get".
+	TokenAcronym                    // A word with at least 2 letters and no lowercase letters, such as "HTML".
+	TokenNumber                     // A word that starts with a digit, such as "11" or "2nd".
+	TokenDelimiter                  // A sequence of delimiters, such as "_" or " - ".
+	TokenInvalid                    // A sequence of bytes that aren't valid UTF-8.
+)
+
+// The names of the kinds of tokens, indexed by kind.
+var tokenKindNames = [...]string{
+	TokenWord:      "Word",
+	TokenAcronym:   "Acronym",
+	TokenNumber:    "Number",
+	TokenDelimiter: "Delimiter",
+	TokenInvalid:   "Invalid",
+}
+
+// String returns the name of k.
+func (k TokenKind) String() string {
+	if k < 0 || int(k) >= len(tokenKindNames) {
+		return "TokenKind(" + strconv.Itoa(int(k)) + ")"
+	}
+
+	return tokenKindNames[k]
+}
+
+// A Token is a part of a string, as returned by a Tokenizer.
+type Token struct {
+	Kind  TokenKind // The kind of the token.
+	Text  string    // The text of the token.
+	Start int       // The offset (in bytes) of the first byte of the token.
+	End   int       // The offset (in bytes) of the byte that follows the token.
+}
+
+// A Tokenizer reads a string as a sequence of tokens, so that tools (such as linters and syntax highlighters) know
+// the kind and the position of each part of the string.
+// The words are the ones produced by Split, but delimiters (underscores, dashes, dots, slashes and whitespace
+// characters) and bytes that aren't valid UTF-8 are returned as separate tokens, so the tokens cover the whole string.
+// A Tokenizer should be created using NewTokenizer.
+type Tokenizer struct {
+	input   string // The data this tokenizer operates on.
+	pos     int    // The position (in input) of the next token that isn't a word of the current part.
+	noSplit *trie  // The words that shouldn't be split.
+	part    rdr    // The reader for the current part (a sequence of valid runes without delimiters).
+	partIdx int    // The position (in input) where the current part starts.
+}
+
+// NewTokenizer returns a Tokenizer that reads v.
+// Each word in noSplit (if provided) is treated as a word that shouldn't be split.
+func NewTokenizer(v string, noSplit ...string) *Tokenizer {
+	return &Tokenizer{input: v, noSplit: newTrie(noSplit, false)}
+}
+
+// Next returns the next token, and a flag indicating if there was a next token.
+// Once the whole string is read, an empty Token and false are returned.
+func (t *Tokenizer) Next() (Token, bool) {
+	if t.part.pos < len(t.part.input) {
+		sIdx := t.part.pos
+		w := t.part.readNextPart()
+
+		return Token{Kind: wordKind(w), Text: w, Start: t.partIdx + sIdx, End: t.partIdx + t.part.pos}, true
+	}
+
+	if t.pos >= len(t.input) {
+		return Token{}, false
+	}
+
+	sIdx := t.pos
+	r, _ := utf8.DecodeRuneInString(t.input[sIdx:])
+
+	switch {
+	case isInvalidAt(t.input, sIdx):
+		for t.pos < len(t.input) && isInvalidAt(t.input, t.pos) {
+			t.pos = t.pos + 1
+		}
+
+		return Token{Kind: TokenInvalid, Text: t.input[sIdx:t.pos], Start: sIdx, End: t.pos}, true
+	case isDelimiter(r):
+		for t.pos < len(t.input) {
+			r, size := utf8.DecodeRuneInString(t.input[t.pos:])
+
+			if !isDelimiter(r) {
+				break
+			}
+
+			t.pos = t.pos + size
+		}
+
+		return Token{Kind: TokenDelimiter, Text: t.input[sIdx:t.pos], Start: sIdx, End: t.pos}, true
+	}
+
+	for t.pos < len(t.input) && !isInvalidAt(t.input, t.pos) {
+		r, size := utf8.DecodeRuneInString(t.input[t.pos:])
+
+		if isDelimiter(r) {
+			break
+		}
+
+		t.pos = t.pos + size
+	}
+
+	t.part, t.partIdx = rdr{input: t.input[sIdx:t.pos], noSplit: t.noSplit}, sIdx
+
+	return t.Next()
+}
+
+// Checks whether or not the byte at position idx (in v) starts a sequence of bytes that isn't valid UTF-8.
+func isInvalidAt(v string, idx int) bool {
+	r, size := utf8.DecodeRuneInString(v[idx:])
+
+	return r == utf8.RuneError && size == 1
+}
+
+// Returns the kind of the word w.
+func wordKind(w string) TokenKind {
+	r, _ := utf8.DecodeRuneInString(w)
+
+	switch {
+	case isDigit(r):
+		return TokenNumber
+	case isAcronym(w):
+		return TokenAcronym
+	}
+
+	return TokenWord
+}
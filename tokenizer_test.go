@@ -0,0 +1,134 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Read a string as a sequence of tokens.
+func TestTokenizer(t *testing.T) {
+	for _, tc := range []struct {
+		vInput   string
+		vNoSplit []string
+		want     []camelcase.Token
+	}{
+		{
+			vInput: "",
+			want:   []camelcase.Token{},
+		},
+		{
+			vInput: "parseHTTPHeader_v2 ",
+			want: []camelcase.Token{
+				{Kind: camelcase.TokenWord, Text: "parse", Start: 0, End: 5},
+				{Kind: camelcase.TokenAcronym, Text: "HTTP", Start: 5, End: 9},
+				{Kind: camelcase.TokenWord, Text: "Header", Start: 9, End: 15},
+				{Kind: camelcase.TokenDelimiter, Text: "_", Start: 15, End: 16},
+				{Kind: camelcase.TokenWord, Text: "v", Start: 16, End: 17},
+				{Kind: camelcase.TokenNumber, Text: "2", Start: 17, End: 18},
+				{Kind: camelcase.TokenDelimiter, Text: " ", Start: 18, End: 19},
+			},
+		},
+		{
+			vInput: "__2ndÜberTest\xe2\xe2\xa1X-Ray",
+			want: []camelcase.Token{
+				{Kind: camelcase.TokenDelimiter, Text: "__", Start: 0, End: 2},
+				{Kind: camelcase.TokenNumber, Text: "2nd", Start: 2, End: 5},
+				{Kind: camelcase.TokenWord, Text: "Über", Start: 5, End: 10},
+				{Kind: camelcase.TokenWord, Text: "Test", Start: 10, End: 14},
+				{Kind: camelcase.TokenInvalid, Text: "\xe2\xe2\xa1", Start: 14, End: 17},
+				{Kind: camelcase.TokenWord, Text: "X", Start: 17, End: 18},
+				{Kind: camelcase.TokenDelimiter, Text: "-", Start: 18, End: 19},
+				{Kind: camelcase.TokenWord, Text: "Ray", Start: 19, End: 22},
+			},
+		},
+		{
+			vInput:   "IPv6Address",
+			vNoSplit: []string{"IPv6"},
+			want: []camelcase.Token{
+				{Kind: camelcase.TokenWord, Text: "IPv6", Start: 0, End: 4},
+				{Kind: camelcase.TokenWord, Text: "Address", Start: 4, End: 11},
+			},
+		},
+	} {
+		// ARRANGE.
+		tokenizer := camelcase.NewTokenizer(tc.vInput, tc.vNoSplit...)
+		got := make([]camelcase.Token, 0)
+
+		// ACT.
+		for token, ok := tokenizer.Next(); ok; token, ok = tokenizer.Next() {
+			got = append(got, token)
+		}
+
+		// ASSERT.
+		assert.EqualS(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Read a string as a sequence of tokens.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+	}
+}
+
+// UT: Get the name of a kind of token.
+func TestTokenKindString(t *testing.T) {
+	for _, tc := range []struct {
+		vInput camelcase.TokenKind
+		want   string
+	}{
+		{vInput: camelcase.TokenWord, want: "Word"},
+		{vInput: camelcase.TokenInvalid, want: "Invalid"},
+		{vInput: camelcase.TokenKind(-1), want: "TokenKind(-1)"},
+		{vInput: camelcase.TokenKind(99), want: "TokenKind(99)"},
+	} {
+		// ACT.
+		got := tc.vInput.String()
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Get the name of a kind of token.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", int(tc.vInput), tc.want, got)
+	}
+}
+
+// Benchmark: Read a string as a sequence of tokens.
+func BenchmarkTokenizer(b *testing.B) {
+	// RESET.
+	b.ResetTimer()
+
+	// EXECUTION.
+	for i := 0; i < b.N; i++ {
+		tokenizer := camelcase.NewTokenizer("parseHTTPHeader_GL11Version-userID")
+
+		for _, ok := tokenizer.Next(); ok; _, ok = tokenizer.Next() {
+		}
+	}
+}
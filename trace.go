@@ -0,0 +1,129 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import (
+	"strconv"
+	"unicode/utf8"
+)
+
+// Reason defines why a word boundary was (or wasn't) inserted between 2 runes.
+type Reason int
+
+// The supported reasons.
+const (
+	ReasonNone       Reason = iota // The runes continue the same word ("ab", "Ab").
+	ReasonCaseChange               // An uppercase rune follows a rune that isn't uppercase ("aB").
+	ReasonAcronymEnd               // An acronym is followed by a capitalized word ("PS" in "HTTPServer").
+	ReasonDigit                    // A digit follows a rune that isn't a digit, or the other way around ("a2", "2B").
+	ReasonOrdinal                  // A number is followed by its ordinal suffix ("2nd").
+	ReasonNoSplit                  // The runes are part of a word that shouldn't be split (see noSplit).
+	ReasonOther                    // The word ends for another reason ("Y_" in "MY_VALUE").
+)
+
+// The names of the reasons, indexed by reason.
+var reasonNames = [...]string{
+	ReasonNone:       "None",
+	ReasonCaseChange: "CaseChange",
+	ReasonAcronymEnd: "AcronymEnd",
+	ReasonDigit:      "Digit",
+	ReasonOrdinal:    "Ordinal",
+	ReasonNoSplit:    "NoSplit",
+	ReasonOther:      "Other",
+}
+
+// String returns the name of r.
+func (r Reason) String() string {
+	if r < 0 || int(r) >= len(reasonNames) {
+		return "Reason(" + strconv.Itoa(int(r)) + ")"
+	}
+
+	return reasonNames[r]
+}
+
+// A Decision explains whether or not a word boundary was inserted between 2 runes, and why.
+type Decision struct {
+	Offset int    // The offset (in bytes) of the next rune.
+	Prev   rune   // The rune before the offset.
+	Next   rune   // The rune at the offset.
+	Split  bool   // A flag indicating if a word boundary was inserted.
+	Reason Reason // The reason why a word boundary was (or wasn't) inserted.
+}
+
+// SplitTrace splits v like Split does, and returns a Decision for each pair of adjacent runes, which explains why a
+// word boundary was (or wasn't) inserted between them. A rune and the combining marks that follow it are never split,
+// so no decision is returned for the marks.
+// If v isn't a valid UTF-8 string, or when v is an empty string, a slice with one element (v) and no decisions are
+// returned.
+// Each word in noSplit (if provided) is treated as a word that shouldn't be split.
+func SplitTrace(v string, noSplit ...string) ([]string, []Decision) {
+	if !utf8.ValidString(v) || len(v) == 0 {
+		return []string{v}, nil
+	}
+
+	words, boundaries := make([]string, 0), make(map[int]bool)
+
+	for _, idx := range SplitIndexes(v, noSplit...) {
+		words, boundaries[idx[0]] = append(words, v[idx[0]:idx[1]]), true
+	}
+
+	// NOTE: The words that shouldn't be split are detected by comparing the boundaries with the ones without noSplit.
+	defaults := make(map[int]bool)
+
+	if len(noSplit) > 0 {
+		for _, idx := range SplitIndexes(v) {
+			defaults[idx[0]] = true
+		}
+	}
+
+	retVal := make([]Decision, 0)
+	prev := decodeRune(v)
+
+	for idx := prev.size; idx < len(v); {
+		next := decodeRune(v[idx:])
+		d := Decision{Offset: idx, Prev: prev.r, Next: next.r, Split: boundaries[idx]}
+
+		switch {
+		case !d.Split && defaults[idx]:
+			d.Reason = ReasonNoSplit
+		case !d.Split && prev.isDigit() && next.isLowercase():
+			d.Reason = ReasonOrdinal
+		case d.Split && prev.isDigit() != next.isDigit():
+			d.Reason = ReasonDigit
+		case d.Split && prev.isUppercase() && next.isUppercase():
+			d.Reason = ReasonAcronymEnd
+		case d.Split && next.isUppercase():
+			d.Reason = ReasonCaseChange
+		case d.Split:
+			d.Reason = ReasonOther
+		}
+
+		retVal = append(retVal, d)
+		prev, idx = next, idx+next.size
+	}
+
+	return words, retVal
+}
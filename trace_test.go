@@ -0,0 +1,154 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Split a string into words, explaining each split decision.
+func TestSplitTrace(t *testing.T) {
+	for _, tc := range []struct {
+		vInput        string
+		vNoSplit      []string
+		wantWords     []string
+		wantDecisions []camelcase.Decision
+	}{
+		{
+			vInput:        "",
+			wantWords:     []string{""},
+			wantDecisions: []camelcase.Decision{},
+		},
+		{
+			vInput:        "BadUTF8\xe2\xe2\xa1",
+			wantWords:     []string{"BadUTF8\xe2\xe2\xa1"},
+			wantDecisions: []camelcase.Decision{},
+		},
+		{
+			vInput:    "getHTTPServer2nd",
+			wantWords: []string{"get", "HTTP", "Server", "2nd"},
+			wantDecisions: []camelcase.Decision{
+				{Offset: 1, Prev: 'g', Next: 'e', Reason: camelcase.ReasonNone},
+				{Offset: 2, Prev: 'e', Next: 't', Reason: camelcase.ReasonNone},
+				{Offset: 3, Prev: 't', Next: 'H', Split: true, Reason: camelcase.ReasonCaseChange},
+				{Offset: 4, Prev: 'H', Next: 'T', Reason: camelcase.ReasonNone},
+				{Offset: 5, Prev: 'T', Next: 'T', Reason: camelcase.ReasonNone},
+				{Offset: 6, Prev: 'T', Next: 'P', Reason: camelcase.ReasonNone},
+				{Offset: 7, Prev: 'P', Next: 'S', Split: true, Reason: camelcase.ReasonAcronymEnd},
+				{Offset: 8, Prev: 'S', Next: 'e', Reason: camelcase.ReasonNone},
+				{Offset: 9, Prev: 'e', Next: 'r', Reason: camelcase.ReasonNone},
+				{Offset: 10, Prev: 'r', Next: 'v', Reason: camelcase.ReasonNone},
+				{Offset: 11, Prev: 'v', Next: 'e', Reason: camelcase.ReasonNone},
+				{Offset: 12, Prev: 'e', Next: 'r', Reason: camelcase.ReasonNone},
+				{Offset: 13, Prev: 'r', Next: '2', Split: true, Reason: camelcase.ReasonDigit},
+				{Offset: 14, Prev: '2', Next: 'n', Reason: camelcase.ReasonOrdinal},
+				{Offset: 15, Prev: 'n', Next: 'd', Reason: camelcase.ReasonNone},
+			},
+		},
+		{
+			vInput:    "OAuth2Token",
+			vNoSplit:  []string{"OAuth2"},
+			wantWords: []string{"OAuth2", "Token"},
+			wantDecisions: []camelcase.Decision{
+				{Offset: 1, Prev: 'O', Next: 'A', Reason: camelcase.ReasonNoSplit},
+				{Offset: 2, Prev: 'A', Next: 'u', Reason: camelcase.ReasonNone},
+				{Offset: 3, Prev: 'u', Next: 't', Reason: camelcase.ReasonNone},
+				{Offset: 4, Prev: 't', Next: 'h', Reason: camelcase.ReasonNone},
+				{Offset: 5, Prev: 'h', Next: '2', Reason: camelcase.ReasonNoSplit},
+				{Offset: 6, Prev: '2', Next: 'T', Split: true, Reason: camelcase.ReasonDigit},
+				{Offset: 7, Prev: 'T', Next: 'o', Reason: camelcase.ReasonNone},
+				{Offset: 8, Prev: 'o', Next: 'k', Reason: camelcase.ReasonNone},
+				{Offset: 9, Prev: 'k', Next: 'e', Reason: camelcase.ReasonNone},
+				{Offset: 10, Prev: 'e', Next: 'n', Reason: camelcase.ReasonNone},
+			},
+		},
+		{
+			vInput:    "MY_ID",
+			wantWords: []string{"MY", "_ID"},
+			wantDecisions: []camelcase.Decision{
+				{Offset: 1, Prev: 'M', Next: 'Y', Reason: camelcase.ReasonNone},
+				{Offset: 2, Prev: 'Y', Next: '_', Split: true, Reason: camelcase.ReasonOther},
+				{Offset: 3, Prev: '_', Next: 'I', Reason: camelcase.ReasonNone},
+				{Offset: 4, Prev: 'I', Next: 'D', Reason: camelcase.ReasonNone},
+			},
+		},
+		{
+			vInput:    "cafe\u0301Bar",
+			wantWords: []string{"cafe\u0301", "Bar"},
+			wantDecisions: []camelcase.Decision{
+				{Offset: 1, Prev: 'c', Next: 'a', Reason: camelcase.ReasonNone},
+				{Offset: 2, Prev: 'a', Next: 'f', Reason: camelcase.ReasonNone},
+				{Offset: 3, Prev: 'f', Next: 'e', Reason: camelcase.ReasonNone},
+				{Offset: 6, Prev: 'e', Next: 'B', Split: true, Reason: camelcase.ReasonCaseChange},
+				{Offset: 7, Prev: 'B', Next: 'a', Reason: camelcase.ReasonNone},
+				{Offset: 8, Prev: 'a', Next: 'r', Reason: camelcase.ReasonNone},
+			},
+		},
+	} {
+		// ACT.
+		gotWords, gotDecisions := camelcase.SplitTrace(tc.vInput, tc.vNoSplit...)
+
+		// ASSERT.
+		assert.EqualS(t, gotWords, tc.wantWords, "", "\n\n"+
+			"UT Name:  Split a string into words, explaining each split decision.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected (words): %v\033[0m\n"+
+			"\033[31mActual (words):   %v\033[0m\n\n", tc.vInput, tc.wantWords, gotWords)
+
+		assert.EqualS(t, gotDecisions, tc.wantDecisions, "", "\n\n"+
+			"UT Name:  Split a string into words, explaining each split decision.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected (decisions): %v\033[0m\n"+
+			"\033[31mActual (decisions):   %v\033[0m\n\n", tc.vInput, tc.wantDecisions, gotDecisions)
+	}
+}
+
+// UT: Get the name of a reason.
+func TestReasonString(t *testing.T) {
+	for _, tc := range []struct {
+		vInput camelcase.Reason
+		want   string
+	}{
+		{vInput: camelcase.ReasonNone, want: "None"},
+		{vInput: camelcase.ReasonOther, want: "Other"},
+		{vInput: camelcase.Reason(-1), want: "Reason(-1)"},
+		{vInput: camelcase.Reason(99), want: "Reason(99)"},
+	} {
+		// ACT.
+		got := tc.vInput.String()
+
+		// ASSERT.
+		assert.Equal(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Get the name of a reason.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", int(tc.vInput), tc.want, got)
+	}
+}
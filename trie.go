@@ -0,0 +1,135 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// A prefix tree (trie) holding the words that shouldn't be split.
+// Each node represents a prefix of one (or more) of the words in the tree.
+type trie struct {
+	children map[rune]*trie // The nodes representing the prefixes that are 1 rune longer.
+	terminal bool           // A flag indicating if the prefix represented by this node is a complete word.
+	foldCase bool           // A flag indicating if runes are matched case-insensitively.
+}
+
+// Returns a trie holding each word in words, or nil if words is empty.
+// If foldCase is true, runes are matched case-insensitively (under Unicode case-folding).
+func newTrie(words []string, foldCase bool) *trie {
+	if len(words) == 0 {
+		return nil
+	}
+
+	root := &trie{foldCase: foldCase}
+
+	for _, w := range words {
+		root.insert(w)
+	}
+
+	return root
+}
+
+// Add w to t.
+func (t *trie) insert(w string) {
+	node := t
+
+	for _, r := range w {
+		r = t.key(r)
+		child, ok := node.children[r]
+
+		if !ok {
+			child = &trie{foldCase: t.foldCase}
+
+			if node.children == nil {
+				node.children = make(map[rune]*trie)
+			}
+
+			node.children[r] = child
+		}
+
+		node = child
+	}
+
+	node.terminal = true
+}
+
+// Returns the node representing the prefix of t followed by r, or nil if there's no such node.
+func (t *trie) next(r rune) *trie {
+	return t.children[t.key(r)]
+}
+
+// Returns the node representing the prefix of t followed by each rune in v, or nil if there's no such node.
+func (t *trie) walk(v string) *trie {
+	node := t
+
+	for _, r := range v {
+		if node = node.next(r); node == nil {
+			return nil
+		}
+	}
+
+	return node
+}
+
+// Returns the length (in bytes) of the longest word in t that's a prefix of v and for which accept returns true, or 0
+// if there's no such word.
+func (t *trie) longestPrefix(v string, accept func(n int) bool) int {
+	retVal := 0
+	node := t
+
+	for idx, r := range v {
+		if node = node.next(r); node == nil {
+			break
+		}
+
+		if n := idx + utf8.RuneLen(r); node.terminal && accept(n) {
+			retVal = n
+		}
+	}
+
+	return retVal
+}
+
+// Returns the key under which r is stored in t.
+// When t matches runes case-insensitively, the key is the smallest rune that's equivalent to r under Unicode
+// case-folding.
+func (t *trie) key(r rune) rune {
+	if !t.foldCase {
+		return r
+	}
+
+	retVal := r
+
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f < retVal {
+			retVal = f
+		}
+	}
+
+	return retVal
+}
@@ -0,0 +1,55 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+// The common unit and currency suffixes of numbers (such as "Sec" in "Timeout30Sec" or "USD" in "Max100USD").
+var unitSuffixes = []string{
+	"USD", "EUR", "GBP", "JPY", "CNY", "CHF", "CAD", "AUD", "BTC", "Ns", "Us", "Ms", "Sec", "Secs", "Seconds", "Min",
+	"Mins", "Minutes", "Hr", "Hrs", "Hours", "Days", "KB", "MB", "GB", "TB", "PB", "KiB", "MiB", "GiB", "TiB",
+	"Bps", "Kbps", "Mbps", "Gbps", "Hz", "KHz", "MHz", "GHz", "Px", "Pct", "Percent",
+}
+
+// UnitSuffixes returns the common unit and currency suffixes of numbers, such as "Sec" (in "Timeout30Sec"), "USD"
+// (in "Max100USDLimit") and "MB" (in "Cache512MB").
+// The returned slice is a copy and can be modified safely.
+func UnitSuffixes() []string {
+	return append([]string(nil), unitSuffixes...)
+}
+
+// WithUnitSuffixes returns an Option that keeps each unit (or currency) suffix in suffixes with the number it follows,
+// so "Max100USDLimit" is split into "Max", "100USD" and "Limit", and "Timeout30Sec" into "Timeout" and "30Sec".
+// The suffixes are matched case-insensitively, and only when they are followed by a word boundary, so "Timeout30Secs"
+// isn't split into "Timeout", "30Sec" and "s" when suffixes contains "Sec", but not "Secs".
+// If no suffixes are provided, the common suffixes (see UnitSuffixes) are used.
+func WithUnitSuffixes(suffixes ...string) Option {
+	if len(suffixes) == 0 {
+		suffixes = unitSuffixes
+	}
+
+	return func(s *Splitter) {
+		s.units = append(s.units, suffixes...)
+	}
+}
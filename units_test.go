@@ -0,0 +1,75 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Split a string, keeping unit suffixes with their number.
+func TestWithUnitSuffixes(t *testing.T) {
+	for _, tc := range []struct {
+		vInput    string
+		vSuffixes []string
+		want      []string
+	}{
+		{vInput: "Max100USDLimit", want: []string{"Max", "100USD", "Limit"}},
+		{vInput: "Timeout30Sec_retry_5sec", want: []string{"Timeout", "30Sec", "retry", "5sec"}},
+		{vInput: "Cache512MiB", want: []string{"Cache", "512MiB"}},
+		{vInput: "Timeout30Secs", vSuffixes: []string{"Sec"}, want: []string{"Timeout", "30", "Secs"}},
+		{vInput: "Top10Users", want: []string{"Top", "10", "Users"}},
+		{vInput: "Price9EURTax2nd", vSuffixes: []string{"EUR"}, want: []string{"Price", "9EUR", "Tax", "2nd"}},
+	} {
+		// ACT.
+		got := camelcase.New(camelcase.WithUnitSuffixes(tc.vSuffixes...)).Split(tc.vInput)
+
+		// ASSERT.
+		assert.EqualS(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Split a string, keeping unit suffixes with their number.\n"+
+			"Input:    %v (suffixes: %v)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vSuffixes, tc.want, got)
+	}
+}
+
+// UT: Modifying the slice returned by UnitSuffixes doesn't modify the common unit suffixes.
+func TestUnitSuffixesIsCopy(t *testing.T) {
+	// ARRANGE.
+	camelcase.UnitSuffixes()[0] = "Changed"
+
+	// ACT.
+	got := camelcase.UnitSuffixes()[0]
+
+	// ASSERT.
+	assert.Equal(t, got, "USD", "", "\n\n"+
+		"UT Name:  Modifying the slice returned by UnitSuffixes doesn't modify the common unit suffixes.\n"+
+		"\033[32mExpected: %v\033[0m\n"+
+		"\033[31mActual:   %v\033[0m\n\n", "USD", got)
+}
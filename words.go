@@ -0,0 +1,162 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import "unicode/utf8"
+
+// Words holds the words of a string, which can be formatted in different naming conventions.
+// The zero value holds no words and is ready to use.
+type Words struct {
+	words []string
+	cm    caseMapping // The case mappings, used when formatting the words.
+}
+
+// Parse splits v into words (on delimiters and on "CamelCase" boundaries) so it can be formatted in different naming
+// conventions without splitting v again.
+// When v consists of multiple words and doesn't contain any lowercase runes (such as "MY_CONSTANT_VALUE"), the words
+// are converted to lowercase, like Convert does, so they aren't formatted as acronyms.
+// If v isn't a valid UTF-8 string, the returned Words holds one word (v).
+// Each word in noSplit (if provided) is treated as a word that shouldn't be split.
+func Parse(v string, noSplit ...string) Words {
+	if !utf8.ValidString(v) {
+		return Words{words: []string{v}}
+	}
+
+	return Words{words: caseMapping{}.lowerAllCaps(splitWords(v, noSplit), v)}
+}
+
+// Len returns the number of words in w.
+func (w Words) Len() int {
+	return len(w.words)
+}
+
+// At returns the word at index idx in w.
+// It panics if idx is out of range.
+func (w Words) At(idx int) string {
+	return w.words[idx]
+}
+
+// Slice returns a copy of the words in w.
+func (w Words) Slice() []string {
+	return append([]string(nil), w.words...)
+}
+
+// Insert returns a copy of w with words inserted at index idx, so that the first inserted word is at index idx.
+// Each element of words is split into words (on delimiters and on "CamelCase" boundaries), so inserting "UserAccount"
+// inserts the words "User" and "Account".
+// It panics if idx is out of range (it may be equal to the number of words in w).
+func (w Words) Insert(idx int, words ...string) Words {
+	if idx < 0 || idx > len(w.words) {
+		panic("camelcase: index out of range")
+	}
+
+	retVal := make([]string, 0, len(w.words)+len(words))
+	retVal = append(retVal, w.words[:idx]...)
+
+	for _, v := range words {
+		retVal = appendParsed(retVal, v)
+	}
+
+	return Words{words: append(retVal, w.words[idx:]...), cm: w.cm}
+}
+
+// Remove returns a copy of w without the word at index idx.
+// It panics if idx is out of range.
+func (w Words) Remove(idx int) Words {
+	if idx < 0 || idx >= len(w.words) {
+		panic("camelcase: index out of range")
+	}
+
+	retVal := make([]string, 0, len(w.words)-1)
+	retVal = append(retVal, w.words[:idx]...)
+
+	return Words{words: append(retVal, w.words[idx+1:]...), cm: w.cm}
+}
+
+// Prepend returns a copy of w with words inserted before the first word of w (see Insert).
+func (w Words) Prepend(words ...string) Words {
+	return w.Insert(0, words...)
+}
+
+// Append returns a copy of w with words added after the last word of w (see Insert).
+func (w Words) Append(words ...string) Words {
+	return w.Insert(len(w.words), words...)
+}
+
+// Map returns a copy of w where each word is replaced by the result of fn.
+// Words for which fn returns an empty string are removed.
+func (w Words) Map(fn func(word string) string) Words {
+	retVal := make([]string, 0, len(w.words))
+
+	for _, v := range w.words {
+		if v = fn(v); len(v) > 0 {
+			retVal = append(retVal, v)
+		}
+	}
+
+	return Words{words: retVal, cm: w.cm}
+}
+
+// Split v into words and append them to dst.
+// If v isn't a valid UTF-8 string, v is appended as a single word.
+func appendParsed(dst []string, v string) []string {
+	if !utf8.ValidString(v) {
+		return append(dst, v)
+	}
+
+	return newDefaultSplitter(nil, nil).appendWords(dst, v)
+}
+
+// Format combines the words in w into a single string using the naming convention to.
+// When w was created by a Splitter, the case mappings of that Splitter are used.
+func (w Words) Format(to Style) string {
+	return w.cm.format(w.words, to)
+}
+
+// Camel combines the words in w into a "camelCase" string.
+func (w Words) Camel() string {
+	return w.Format(Camel)
+}
+
+// Pascal combines the words in w into a "PascalCase" string.
+func (w Words) Pascal() string {
+	return w.Format(Pascal)
+}
+
+// Snake combines the words in w into a "snake_case" string.
+func (w Words) Snake() string {
+	return w.Format(Snake)
+}
+
+// Kebab combines the words in w into a "kebab-case" string.
+func (w Words) Kebab() string {
+	return w.Format(Kebab)
+}
+
+// Title combines the words in w into a "Title Case" string.
+func (w Words) Title() string {
+	return w.Format(Title)
+}
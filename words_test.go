@@ -0,0 +1,197 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Parse a string into words.
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		vInput   string
+		vNoSplit []string
+		want     []string
+	}{
+		{
+			vInput: "",
+			want:   []string{},
+		},
+		{
+			vInput: "parseHTMLDocument",
+			want:   []string{"parse", "HTML", "Document"},
+		},
+		{
+			vInput: "user_id-field",
+			want:   []string{"user", "id", "field"},
+		},
+		{
+			vInput:   "Tls2Config",
+			vNoSplit: []string{"Tls2"},
+			want:     []string{"Tls2", "Config"},
+		},
+		{
+			vInput: "MY_VALUE",
+			want:   []string{"my", "value"},
+		},
+		{
+			vInput: "HTML",
+			want:   []string{"HTML"},
+		},
+		{
+			vInput: "BadUTF8\xe2\xe2\xa1",
+			want:   []string{"BadUTF8\xe2\xe2\xa1"},
+		},
+	} {
+		// ACT.
+		words := camelcase.Parse(tc.vInput, tc.vNoSplit...)
+		got := make([]string, 0, words.Len())
+
+		for idx := 0; idx < words.Len(); idx++ {
+			got = append(got, words.At(idx))
+		}
+
+		// ASSERT.
+		assert.EqualS(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Parse a string into words.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+
+		assert.EqualS(t, words.Slice(), tc.want, "", "\n\n"+
+			"UT Name:  Parse a string into words.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, words.Slice())
+	}
+}
+
+// UT: Parse, Splitter.Parse and Convert format a string without lowercase runes identically.
+func TestParseAllCaps(t *testing.T) {
+	for _, v := range []string{"MY_VALUE", "HTTP_SERVER_ID", "HTML"} {
+		// ACT.
+		got := []string{camelcase.Parse(v).Pascal(), camelcase.New().Parse(v).Pascal()}
+
+		// ASSERT.
+		want := camelcase.Convert(v, camelcase.Pascal)
+
+		assert.EqualS(t, got, []string{want, want}, "", "\n\n"+
+			"UT Name:  Parse, Splitter.Parse and Convert format a string without lowercase runes identically.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", v, want, got)
+	}
+}
+
+// UT: Format parsed words using different naming conventions.
+func TestWordsFormat(t *testing.T) {
+	// ARRANGE.
+	words := camelcase.Parse("parseHTMLDocument")
+
+	for _, tc := range []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "Camel", got: words.Camel(), want: "parseHTMLDocument"},
+		{name: "Pascal", got: words.Pascal(), want: "ParseHTMLDocument"},
+		{name: "Snake", got: words.Snake(), want: "parse_html_document"},
+		{name: "Kebab", got: words.Kebab(), want: "parse-html-document"},
+		{name: "Title", got: words.Title(), want: "Parse HTML Document"},
+		{name: "Format", got: words.Format(camelcase.Dot), want: "parse.html.document"},
+	} {
+		// ASSERT.
+		assert.Equal(t, tc.got, tc.want, "", "\n\n"+
+			"UT Name:  Format parsed words using different naming conventions.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.name, tc.want, tc.got)
+	}
+}
+
+// UT: Edit parsed words.
+func TestWordsEdit(t *testing.T) {
+	// ARRANGE.
+	words := camelcase.Parse("UserRepository")
+
+	for _, tc := range []struct {
+		name string
+		got  camelcase.Words
+		want string
+	}{
+		{name: "Insert", got: words.Insert(1, "AccountHTTP"), want: "UserAccountHTTPRepository"},
+		{name: "Insert (start)", got: words.Insert(0, "new"), want: "NewUserRepository"},
+		{name: "Insert (end)", got: words.Insert(2, "impl"), want: "UserRepositoryImpl"},
+		{name: "Remove", got: words.Remove(0), want: "Repository"},
+		{name: "Prepend", got: words.Prepend("New"), want: "NewUserRepository"},
+		{name: "Append", got: words.Append("mock", "Impl"), want: "UserRepositoryMockImpl"},
+		{name: "Chain", got: words.Remove(0).Prepend("New", "customer_order"), want: "NewCustomerOrderRepository"},
+		{
+			name: "Map",
+			got: words.Map(func(w string) string {
+				if w == "Repository" {
+					return "Repo"
+				}
+
+				return w
+			}),
+			want: "UserRepo",
+		},
+		{
+			name: "Map (remove)",
+			got:  words.Map(func(w string) string { return strings.TrimPrefix(w, "User") }),
+			want: "Repository",
+		},
+		{name: "Original (unmodified)", got: words, want: "UserRepository"},
+	} {
+		// ASSERT.
+		assert.Equal(t, tc.got.Pascal(), tc.want, "", "\n\n"+
+			"UT Name:  Edit parsed words.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.name, tc.want, tc.got.Pascal())
+	}
+}
+
+// UT: Modifying the slice returned by Words.Slice doesn't modify the words.
+func TestWordsSliceIsCopy(t *testing.T) {
+	// ARRANGE.
+	words := camelcase.Parse("MultipleWords")
+
+	// ACT.
+	words.Slice()[0] = "Changed"
+
+	// ASSERT.
+	assert.Equal(t, words.At(0), "Multiple", "", "\n\n"+
+		"UT Name:  Modifying the slice returned by Words.Slice doesn't modify the words.\n"+
+		"\033[32mExpected: %v\033[0m\n"+
+		"\033[31mActual:   %v\033[0m\n\n", "Multiple", words.At(0))
+}
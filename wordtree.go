@@ -0,0 +1,119 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+// WordTree is a prefix tree (trie) of identifiers, keyed by their words, so that identifiers sharing leading words are
+// grouped together (e.g. "UserCreate", "UserDelete" and "UserList" are all found under the node "User").
+// Each node represents a sequence of leading words, and holds the identifiers that consist of exactly those words.
+type WordTree struct {
+	word     string               // The last word of the sequence represented by this node (empty for the root).
+	names    []string             // The identifiers that consist of exactly the words represented by this node.
+	children []*WordTree          // The nodes representing the sequences that are 1 word longer (in insertion order).
+	index    map[string]*WordTree // The nodes representing the sequences that are 1 word longer (by word).
+}
+
+// NewWordTree returns a WordTree holding names.
+// Each name is split into words on delimiters and on "CamelCase" boundaries, and the words are compared exactly.
+func NewWordTree(names []string) *WordTree {
+	root := &WordTree{}
+
+	for _, name := range names {
+		node := root
+
+		for _, w := range splitWords(name, nil) {
+			node = node.child(w)
+		}
+
+		node.names = append(node.names, name)
+	}
+
+	return root
+}
+
+// Returns the child of t representing the sequence of t followed by w, adding it when it doesn't exist yet.
+func (t *WordTree) child(w string) *WordTree {
+	if child, ok := t.index[w]; ok {
+		return child
+	}
+
+	if t.index == nil {
+		t.index = make(map[string]*WordTree)
+	}
+
+	child := &WordTree{word: w}
+	t.index[w] = child
+	t.children = append(t.children, child)
+
+	return child
+}
+
+// Word returns the last word of the sequence represented by t, or an empty string when t is the root.
+func (t *WordTree) Word() string {
+	return t.word
+}
+
+// Names returns a copy of the identifiers that consist of exactly the words represented by t.
+func (t *WordTree) Names() []string {
+	return append([]string(nil), t.names...)
+}
+
+// Children returns the nodes representing the sequences that are 1 word longer than the one of t, in the order in
+// which they were added.
+func (t *WordTree) Children() []*WordTree {
+	return append([]*WordTree(nil), t.children...)
+}
+
+// Lookup returns the node representing the sequence of t followed by words, or nil if there's no such node.
+// Each element of words is split into words, so Lookup("User", "Create") and Lookup("UserCreate") are equivalent.
+func (t *WordTree) Lookup(words ...string) *WordTree {
+	node := t
+
+	for _, w := range splitAll(words) {
+		if node = node.index[w]; node == nil {
+			return nil
+		}
+	}
+
+	return node
+}
+
+// Walk calls fn for each node in t (depth-first, starting with t itself), passing the words that lead from t to the
+// node.
+// If fn returns false, the children of that node aren't visited.
+func (t *WordTree) Walk(fn func(path []string, node *WordTree) bool) {
+	t.walk(make([]string, 0), fn)
+}
+
+// Call fn for t and each node below it, where path holds the words that lead to t.
+func (t *WordTree) walk(path []string, fn func(path []string, node *WordTree) bool) {
+	if !fn(path, t) {
+		return
+	}
+
+	for _, child := range t.children {
+		child.walk(append(path[:len(path):len(path)], child.word), fn)
+	}
+}
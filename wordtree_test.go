@@ -0,0 +1,126 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Look up identifiers in a WordTree.
+func TestWordTreeLookup(t *testing.T) {
+	// ARRANGE.
+	tree := camelcase.NewWordTree([]string{"UserCreate", "UserDelete", "user_list", "User", "OrderCreate"})
+
+	for _, tc := range []struct {
+		vWords       []string
+		wantFound    bool
+		wantNames    []string
+		wantChildren []string
+	}{
+		{
+			vWords:       []string{},
+			wantFound:    true,
+			wantNames:    []string{},
+			wantChildren: []string{"User", "user", "Order"},
+		},
+		{
+			vWords:       []string{"User"},
+			wantFound:    true,
+			wantNames:    []string{"User"},
+			wantChildren: []string{"Create", "Delete"},
+		},
+		{
+			vWords:       []string{"User", "Create"},
+			wantFound:    true,
+			wantNames:    []string{"UserCreate"},
+			wantChildren: []string{},
+		},
+		{vWords: []string{"UserDelete"}, wantFound: true, wantNames: []string{"UserDelete"}, wantChildren: []string{}},
+		{vWords: []string{"user"}, wantFound: true, wantNames: []string{}, wantChildren: []string{"list"}},
+		{vWords: []string{"User", "List"}, wantFound: false},
+		{vWords: []string{"Customer"}, wantFound: false},
+	} {
+		// ACT.
+		node := tree.Lookup(tc.vWords...)
+
+		// ASSERT.
+		assert.Equal(t, node != nil, tc.wantFound, "", "\n\n"+
+			"UT Name:  Look up identifiers in a WordTree.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected (found): %v\033[0m\n"+
+			"\033[31mActual (found):   %v\033[0m\n\n", tc.vWords, tc.wantFound, node != nil)
+
+		if node == nil {
+			continue
+		}
+
+		names := append([]string{}, node.Names()...)
+		children := make([]string, 0)
+
+		for _, child := range node.Children() {
+			children = append(children, child.Word())
+		}
+
+		assert.EqualS(t, names, tc.wantNames, "", "\n\n"+
+			"UT Name:  Look up identifiers in a WordTree.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected (names): %v\033[0m\n"+
+			"\033[31mActual (names):   %v\033[0m\n\n", tc.vWords, tc.wantNames, names)
+
+		assert.EqualS(t, children, tc.wantChildren, "", "\n\n"+
+			"UT Name:  Look up identifiers in a WordTree.\n"+
+			"Input:    %v\n"+
+			"\033[32mExpected (children): %v\033[0m\n"+
+			"\033[31mActual (children):   %v\033[0m\n\n", tc.vWords, tc.wantChildren, children)
+	}
+}
+
+// UT: Walk the nodes of a WordTree.
+func TestWordTreeWalk(t *testing.T) {
+	// ARRANGE.
+	tree := camelcase.NewWordTree([]string{"UserCreate", "UserDelete", "OrderCreate", "OrderItemAdd"})
+	want := []string{"", "User", "User.Create", "User.Delete", "Order", "Order.Create", "Order.Item"}
+
+	// ACT.
+	got := make([]string, 0)
+
+	tree.Walk(func(path []string, node *camelcase.WordTree) bool {
+		got = append(got, strings.Join(path, "."))
+
+		return len(path) < 2
+	})
+
+	// ASSERT.
+	assert.EqualS(t, got, want, "", "\n\n"+
+		"UT Name:  Walk the nodes of a WordTree.\n"+
+		"\033[32mExpected: %v\033[0m\n"+
+		"\033[31mActual:   %v\033[0m\n\n", want, got)
+}
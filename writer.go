@@ -0,0 +1,218 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import (
+	"bufio"
+	"io"
+	"unicode"
+	"unicode/utf8"
+)
+
+// WordWriter is an io.Writer that splits the "CamelCase" string that's written to it into words.
+// The string can be written incrementally (across multiple calls to Write), and each word is passed to a callback as
+// soon as it's complete.
+// Close must be called after the whole string is written, so that the last word is passed to the callback.
+type WordWriter struct {
+	fn    func(word string) // The function that's called for each word.
+	split bufio.SplitFunc   // The function that's used to find the words.
+	buf   []byte            // The data that's written, but that isn't part of a complete word yet.
+	err   error             // The first error that was encountered.
+}
+
+// NewWordWriter returns a WordWriter that calls fn for each word of the "CamelCase" string that's written to it.
+// It produces the same words as Split, except that an empty string doesn't produce any words.
+// Each word in noSplit (if provided) is treated as a word that shouldn't be split.
+func NewWordWriter(fn func(word string), noSplit ...string) *WordWriter {
+	return &WordWriter{fn: fn, split: splitFunc(noSplit)}
+}
+
+// Write writes p to w, and calls the callback of w for each word that's complete.
+// If the data that's written to w isn't a valid UTF-8 string, an *InvalidUTF8Error is returned, and each subsequent
+// call to Write (or Close) returns the same error.
+func (w *WordWriter) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+
+	w.buf = append(w.buf, p...)
+
+	if err := w.flush(false); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// Close calls the callback of w for the last word that's written to w.
+func (w *WordWriter) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+
+	return w.flush(true)
+}
+
+// Call the callback of w for each word in w.buf that's complete, and remove these words from w.buf.
+// If atEOF is true, the data in w.buf is complete, so each word in w.buf is complete.
+func (w *WordWriter) flush(atEOF bool) error {
+	sIdx := 0
+
+	for sIdx < len(w.buf) {
+		advance, token, err := w.split(w.buf[sIdx:], atEOF)
+
+		if err != nil {
+			w.err = err
+
+			return err
+		}
+
+		if advance == 0 {
+			break
+		}
+
+		w.fn(string(token))
+		sIdx = sIdx + advance
+	}
+
+	w.buf = append(w.buf[:0], w.buf[sIdx:]...)
+
+	return nil
+}
+
+// ConvertWriter is an io.Writer that converts each "CamelCase" identifier in the data that's written to it into a
+// naming convention, and writes the result to an underlying io.Writer.
+// An identifier is a sequence of letters, digits, combining marks and underscores that doesn't start with a digit and
+// that consists of more than one word (e.g. "userId" or "user_id"), so keywords (such as "SELECT") and numbers (such
+// as "1e10") are written unmodified.
+// Close must be called after all the data is written, so that the last identifier is written.
+type ConvertWriter struct {
+	w   io.Writer // The writer that the converted data is written to.
+	to  Style     // The naming convention that identifiers are converted into.
+	buf []byte    // The data that's written, but that isn't converted yet.
+	out []byte    // The converted data (reused between writes).
+}
+
+// NewConvertWriter returns a ConvertWriter that converts each identifier into the naming convention to and writes the
+// result to w.
+func NewConvertWriter(w io.Writer, to Style) *ConvertWriter {
+	return &ConvertWriter{w: w, to: to}
+}
+
+// Write converts each identifier in p and writes the result to the underlying io.Writer of c.
+// An identifier at the end of p might continue in the next call to Write, so it's only written once it's complete.
+func (c *ConvertWriter) Write(p []byte) (int, error) {
+	c.buf = append(c.buf, p...)
+
+	if err := c.flush(false); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// Close converts the last identifier that's written to c and writes it to the underlying io.Writer of c.
+// The underlying io.Writer isn't closed.
+func (c *ConvertWriter) Close() error {
+	return c.flush(true)
+}
+
+// Convert each identifier in c.buf that's complete, write the result to c.w and remove it from c.buf.
+// If atEOF is true, the data in c.buf is complete, so each identifier in c.buf is complete.
+func (c *ConvertWriter) flush(atEOF bool) error {
+	n := len(c.buf)
+
+	// NOTE: A rune that's cut off at the end of the data is only read when the remainder of that rune is available.
+	if !atEOF {
+		n = fullRunesLen(c.buf)
+	}
+
+	c.out = c.out[:0]
+	idx := 0
+
+	for idx < n {
+		r, size := utf8.DecodeRune(c.buf[idx:n])
+
+		if !isIdentifierRune(r) {
+			c.out = append(c.out, c.buf[idx:idx+size]...)
+			idx = idx + size
+
+			continue
+		}
+
+		eIdx := idx
+
+		for eIdx < n {
+			r, size := utf8.DecodeRune(c.buf[eIdx:n])
+
+			if !isIdentifierRune(r) {
+				break
+			}
+
+			eIdx = eIdx + size
+		}
+
+		// NOTE: An identifier that ends at the end of the data might continue in the data that isn't written yet.
+		if eIdx == n && !atEOF {
+			break
+		}
+
+		c.out = append(c.out, c.convert(string(c.buf[idx:eIdx]))...)
+		idx = eIdx
+	}
+
+	c.buf = append(c.buf[:0], c.buf[idx:]...)
+
+	if len(c.out) == 0 {
+		return nil
+	}
+
+	_, err := c.w.Write(c.out)
+
+	return err
+}
+
+// Returns the identifier v converted into the naming convention of c.
+// If v starts with a digit, or if it consists of a single word, v is returned unmodified.
+func (c *ConvertWriter) convert(v string) string {
+	if r, _ := utf8.DecodeRuneInString(v); isDigit(r) {
+		return v
+	}
+
+	words := splitWords(v, nil)
+
+	if len(words) < 2 {
+		return v
+	}
+
+	return caseMapping{}.format(caseMapping{}.lowerAllCaps(words, v), c.to)
+}
+
+// Checks whether or not r is a rune that's part of an identifier (a letter, a digit, a combining mark or an
+// underscore).
+func isIdentifierRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) || isMark(r)
+}
@@ -0,0 +1,182 @@
+// =====================================================================================================================
+// = LICENSE:       Copyright (c) 2023 Kevin De Coninck
+// =
+// =                Permission is hereby granted, free of charge, to any person
+// =                obtaining a copy of this software and associated documentation
+// =                files (the "Software"), to deal in the Software without
+// =                restriction, including without limitation the rights to use,
+// =                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// =                copies of the Software, and to permit persons to whom the
+// =                Software is furnished to do so, subject to the following
+// =                conditions:
+// =
+// =                The above copyright notice and this permission notice shall be
+// =                included in all copies or substantial portions of the Software.
+// =
+// =                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// =                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// =                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// =                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// =                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// =                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// =                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// =                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Quality assurance: Verify (and measure the performance) of the public API of the "camelcase" package.
+package camelcase_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/kdeconinck/assert"
+	"github.com/kdeconinck/camelcase"
+)
+
+// UT: Split a "CamelCase" string that's written incrementally into words.
+func TestWordWriter(t *testing.T) {
+	for _, tc := range []struct {
+		vInput   []string
+		vNoSplit []string
+		want     []string
+	}{
+		{
+			vInput: []string{},
+			want:   []string{},
+		},
+		{
+			vInput: []string{"PDFLoader"},
+			want:   []string{"PDF", "Loader"},
+		},
+		{
+			vInput: []string{"Hel", "loWor", "ld9", "9HT", "MLPar", "ser"},
+			want:   []string{"Hello", "World", "99", "HTML", "Parser"},
+		},
+		{
+			vInput:   []string{"1Tl", "s2IsUsed"},
+			vNoSplit: []string{"Tls2"},
+			want:     []string{"1", "Tls2", "Is", "Used"},
+		},
+		{
+			vInput: []string{"Über\xc3", "\x9cberWichtig"},
+			want:   []string{"Über", "Über", "Wichtig"},
+		},
+	} {
+		// ARRANGE.
+		got := make([]string, 0)
+		w := camelcase.NewWordWriter(func(word string) { got = append(got, word) }, tc.vNoSplit...)
+
+		// ACT.
+		for _, p := range tc.vInput {
+			_, _ = w.Write([]byte(p))
+		}
+
+		err := w.Close()
+
+		// ASSERT.
+		assert.EqualS(t, got, tc.want, "", "\n\n"+
+			"UT Name:  Split a \"CamelCase\" string that's written incrementally into words.\n"+
+			"Input:    %q\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.want, got)
+
+		assert.Equal(t, err, nil, "", "\n\n"+
+			"UT Name:  Split a \"CamelCase\" string that's written incrementally into words.\n"+
+			"Input:    %q\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, nil, err)
+	}
+}
+
+// UT: A WordWriter returns an error when the data that's written to it isn't valid UTF-8.
+func TestWordWriterInvalidUTF8(t *testing.T) {
+	// ARRANGE.
+	got := make([]string, 0)
+	w := camelcase.NewWordWriter(func(word string) { got = append(got, word) })
+
+	// ACT.
+	_, _ = w.Write([]byte("ValidWord"))
+	_, err := w.Write([]byte("\x80Invalid"))
+	closeErr := w.Close()
+
+	// ASSERT.
+	var utf8Err *camelcase.InvalidUTF8Error
+
+	ok := errors.As(err, &utf8Err)
+
+	assert.EqualS(t, got, []string{"Valid"}, "", "\n\n"+
+		"UT Name:  A WordWriter passes the words before the first invalid UTF-8 sequence to its callback.\n"+
+		"\033[32mExpected: %v\033[0m\n"+
+		"\033[31mActual:   %v\033[0m\n\n", []string{"Valid"}, got)
+
+	assert.Equal(t, ok, true, "", "\n\n"+
+		"UT Name:  The error returned by a WordWriter is an *InvalidUTF8Error.\n"+
+		"\033[32mExpected: %v\033[0m\n"+
+		"\033[31mActual:   %v\033[0m\n\n", true, ok)
+
+	assert.Equal(t, utf8Err.Offset, 9, "", "\n\n"+
+		"UT Name:  The error returned by a WordWriter holds the offset of the first invalid UTF-8 sequence.\n"+
+		"\033[32mExpected: %v\033[0m\n"+
+		"\033[31mActual:   %v\033[0m\n\n", 9, utf8Err.Offset)
+
+	assert.Equal(t, closeErr, err, "", "\n\n"+
+		"UT Name:  Closing a WordWriter returns the error that was encountered while writing.\n"+
+		"\033[32mExpected: %v\033[0m\n"+
+		"\033[31mActual:   %v\033[0m\n\n", err, closeErr)
+}
+
+// UT: Convert the identifiers in the data that's written to a ConvertWriter.
+func TestConvertWriter(t *testing.T) {
+	for _, tc := range []struct {
+		vInput []string
+		vTo    camelcase.Style
+		want   string
+	}{
+		{
+			vInput: []string{},
+			vTo:    camelcase.Snake,
+			want:   "",
+		},
+		{
+			vInput: []string{"SELECT userId, createdAt FROM userAccounts WHERE id = 1e10;"},
+			vTo:    camelcase.Snake,
+			want:   "SELECT user_id, created_at FROM user_accounts WHERE id = 1e10;",
+		},
+		{
+			vInput: []string{`{"us`, `er_i`, `d": 1, "created`, `_at": "2023-01-01", "html_`, `body": "ok"}`},
+			vTo:    camelcase.Camel,
+			want:   `{"userId": 1, "createdAt": "2023-01-01", "htmlBody": "ok"}`,
+		},
+		{
+			vInput: []string{"straßeName\xc3", "\x9cberWichtig = 0"},
+			vTo:    camelcase.Kebab,
+			want:   "straße-name-über-wichtig = 0",
+		},
+		{
+			vInput: []string{"invalid\x80UserId"},
+			vTo:    camelcase.Snake,
+			want:   "invalid\x80user_id",
+		},
+	} {
+		// ARRANGE.
+		var sb strings.Builder
+
+		w := camelcase.NewConvertWriter(&sb, tc.vTo)
+
+		// ACT.
+		for _, p := range tc.vInput {
+			_, _ = w.Write([]byte(p))
+		}
+
+		_ = w.Close()
+
+		// ASSERT.
+		assert.Equal(t, sb.String(), tc.want, "", "\n\n"+
+			"UT Name:  Convert the identifiers in the data that's written to a ConvertWriter.\n"+
+			"Input:    %q (%v)\n"+
+			"\033[32mExpected: %v\033[0m\n"+
+			"\033[31mActual:   %v\033[0m\n\n", tc.vInput, tc.vTo, tc.want, sb.String())
+	}
+}